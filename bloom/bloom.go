@@ -0,0 +1,214 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bloom
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+const (
+	// DefaultFPP - default false positive probability of a filter.
+	DefaultFPP = 0.01
+
+	// MinBytes - minimum size of bitset in bytes.
+	MinBytes = 32
+
+	// MaxBytes - maximum size of bitset in bytes.
+	MaxBytes = 128 * 1024 * 1024
+
+	// HeaderProbeSize - number of bytes enough to decode any filter header.
+	// A filter stored in a file is always larger than this size.
+	HeaderProbeSize = 32
+
+	bytesPerBlock = 32
+)
+
+var salt = [8]uint32{
+	0x47b6137b, 0x44974d91, 0x8824ad5b, 0xa2b7289d,
+	0x705495c7, 0x2df1424b, 0x9efc4947, 0x5c6bfb31,
+}
+
+// Hash - returns xxHash64 of plain encoded value. Supported value types are
+// int32, int64, float32, float64, []byte and string. Length of byte array is
+// not included in the hash.
+func Hash(value interface{}) (uint64, error) {
+	switch v := value.(type) {
+	case int32:
+		buf := make([]byte, 4)
+		binary.LittleEndian.PutUint32(buf, uint32(v))
+		return Sum64(buf), nil
+	case int64:
+		buf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(buf, uint64(v))
+		return Sum64(buf), nil
+	case float32:
+		buf := make([]byte, 4)
+		binary.LittleEndian.PutUint32(buf, math.Float32bits(v))
+		return Sum64(buf), nil
+	case float64:
+		buf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(buf, math.Float64bits(v))
+		return Sum64(buf), nil
+	case []byte:
+		return Sum64(v), nil
+	case string:
+		return Sum64([]byte(v)), nil
+	}
+
+	return 0, fmt.Errorf("unsupported bloom filter value type %T", value)
+}
+
+// OptimalNumBytes - returns bitset size in bytes for ndv distinct values with false positive probability fpp.
+func OptimalNumBytes(ndv uint64, fpp float64) int {
+	if fpp <= 0 || fpp >= 1 {
+		fpp = DefaultFPP
+	}
+
+	numBits := -8 * float64(ndv) / math.Log(1-math.Pow(fpp, 1.0/8))
+	return roundNumBytes(numBits / 8)
+}
+
+// roundNumBytes rounds numBytes up to power of two within [MinBytes, MaxBytes].
+func roundNumBytes(numBytes float64) int {
+	if numBytes >= MaxBytes {
+		return MaxBytes
+	}
+
+	n := MinBytes
+	for float64(n) < numBytes {
+		n <<= 1
+	}
+
+	return n
+}
+
+// Filter - denotes split-block Bloom filter.
+type Filter struct {
+	bitset []byte
+}
+
+// New - creates new filter. numBytes is rounded up to power of two within [MinBytes, MaxBytes].
+func New(numBytes int) *Filter {
+	return &Filter{
+		bitset: make([]byte, roundNumBytes(float64(numBytes))),
+	}
+}
+
+// NewFromBitset - creates filter of bitset read from a file.
+func NewFromBitset(bitset []byte) (*Filter, error) {
+	n := len(bitset)
+	if n < MinBytes || n > MaxBytes || n&(n-1) != 0 {
+		return nil, fmt.Errorf("invalid bloom filter size %v", n)
+	}
+
+	return &Filter{bitset: bitset}, nil
+}
+
+// NumBytes - returns size of bitset in bytes.
+func (filter *Filter) NumBytes() int {
+	return len(filter.bitset)
+}
+
+// Bitset - returns bitset of the filter.
+func (filter *Filter) Bitset() []byte {
+	return filter.bitset
+}
+
+func (filter *Filter) block(hash uint64) []byte {
+	numBlocks := uint64(len(filter.bitset) / bytesPerBlock)
+	index := ((hash >> 32) * numBlocks) >> 32
+	return filter.bitset[index*bytesPerBlock : (index+1)*bytesPerBlock]
+}
+
+// Insert - inserts hash of a value.
+func (filter *Filter) Insert(hash uint64) {
+	block := filter.block(hash)
+	key := uint32(hash)
+	for i := range salt {
+		word := binary.LittleEndian.Uint32(block[i*4:])
+		word |= 1 << ((key * salt[i]) >> 27)
+		binary.LittleEndian.PutUint32(block[i*4:], word)
+	}
+}
+
+// Check - returns false if hash of a value was definitely not inserted.
+func (filter *Filter) Check(hash uint64) bool {
+	block := filter.block(hash)
+	key := uint32(hash)
+	for i := range salt {
+		word := binary.LittleEndian.Uint32(block[i*4:])
+		if word&(1<<((key*salt[i])>>27)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MarshalHeader - returns thrift compact encoded BloomFilterHeader.
+func (filter *Filter) MarshalHeader() ([]byte, error) {
+	header := parquet.NewBloomFilterHeader()
+	header.NumBytes = int32(len(filter.bitset))
+	header.Algorithm = &parquet.BloomFilterAlgorithm{BLOCK: parquet.NewSplitBlockAlgorithm()}
+	header.Hash = &parquet.BloomFilterHash{XXHASH: parquet.NewXxHash()}
+	header.Compression = &parquet.BloomFilterCompression{UNCOMPRESSED: parquet.NewUncompressed()}
+
+	ts := thrift.NewTSerializer()
+	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+	return ts.Write(context.TODO(), header)
+}
+
+// MarshalBinary - returns thrift compact encoded BloomFilterHeader followed by bitset.
+func (filter *Filter) MarshalBinary() ([]byte, error) {
+	data, err := filter.MarshalHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, filter.bitset...), nil
+}
+
+// ReadHeader - decodes BloomFilterHeader from the beginning of data and returns the header and its size in bytes.
+func ReadHeader(data []byte) (header *parquet.BloomFilterHeader, size int, err error) {
+	buf := thrift.NewTMemoryBufferLen(len(data))
+	if _, err = buf.Write(data); err != nil {
+		return nil, 0, err
+	}
+
+	header = parquet.NewBloomFilterHeader()
+	protocol := thrift.NewTCompactProtocolFactory().GetProtocol(buf)
+	if err = header.Read(context.TODO(), protocol); err != nil {
+		return nil, 0, err
+	}
+
+	switch {
+	case header.Algorithm.BLOCK == nil:
+		return nil, 0, fmt.Errorf("unsupported bloom filter algorithm %v", header.Algorithm)
+	case header.Hash.XXHASH == nil:
+		return nil, 0, fmt.Errorf("unsupported bloom filter hash %v", header.Hash)
+	case header.Compression.UNCOMPRESSED == nil:
+		return nil, 0, fmt.Errorf("unsupported bloom filter compression %v", header.Compression)
+	}
+
+	return header, len(data) - buf.Len(), nil
+}
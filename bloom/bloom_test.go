@@ -0,0 +1,148 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bloom
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestSum64(t *testing.T) {
+	testCases := []struct {
+		data           string
+		expectedResult uint64
+	}{
+		{"", 0xef46db3751d8e999},
+		{"a", 0xd24ec4f1a98c6e5b},
+		{"as", 0x1c330fb2d66be179},
+		{"asd", 0x631c37ce72a97393},
+		{"asdf", 0x415872f599cea71e},
+		{"Call me Ishmael. Some years ago--never mind how long precisely-", 0x02a2e85470d6fd96},
+	}
+
+	for i, testCase := range testCases {
+		result := Sum64([]byte(testCase.data))
+		if result != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %x, got: %x", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestOptimalNumBytes(t *testing.T) {
+	testCases := []struct {
+		ndv            uint64
+		fpp            float64
+		expectedResult int
+	}{
+		{0, DefaultFPP, MinBytes},
+		{1, DefaultFPP, MinBytes},
+		{1000, DefaultFPP, 2048},
+		{1000000, DefaultFPP, 2097152},
+		{1 << 40, DefaultFPP, MaxBytes},
+	}
+
+	for i, testCase := range testCases {
+		result := OptimalNumBytes(testCase.ndv, testCase.fpp)
+		if result != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	const n = 10000
+
+	filter := New(OptimalNumBytes(n, DefaultFPP))
+	for i := 0; i < n; i++ {
+		hash, err := Hash(int64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		filter.Insert(hash)
+	}
+
+	for i := 0; i < n; i++ {
+		hash, _ := Hash(int64(i))
+		if !filter.Check(hash) {
+			t.Fatalf("expected: %v found, got: not found", i)
+		}
+	}
+
+	falsePositives := 0
+	for i := n; i < 2*n; i++ {
+		hash, _ := Hash(int64(i))
+		if filter.Check(hash) {
+			falsePositives++
+		}
+	}
+
+	if fpp := float64(falsePositives) / n; fpp > 2*DefaultFPP {
+		t.Fatalf("false positive probability %v is too high", fpp)
+	}
+}
+
+func TestFilterMarshalBinary(t *testing.T) {
+	filter := New(1024)
+	for i := 0; i < 100; i++ {
+		hash, _ := Hash(fmt.Sprintf("value-%v", i))
+		filter.Insert(hash)
+	}
+
+	data, err := filter.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header, size, err := ReadHeader(data[:HeaderProbeSize])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if header.NumBytes != 1024 {
+		t.Fatalf("expected: 1024, got: %v", header.NumBytes)
+	}
+
+	result, err := NewFromBitset(data[size:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(result.Bitset(), filter.Bitset()) {
+		t.Fatalf("bitset mismatch")
+	}
+}
+
+func TestHash(t *testing.T) {
+	hash1, err := Hash("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash2, err := Hash([]byte("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash1 != hash2 {
+		t.Fatalf("expected: %x, got: %x", hash1, hash2)
+	}
+
+	if _, err = Hash(true); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+}
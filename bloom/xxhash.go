@@ -0,0 +1,94 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bloom
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+var (
+	prime64v1 uint64 = 11400714785074694791
+	prime64v2 uint64 = 14029467366897019727
+	prime64v3 uint64 = 1609587929392839161
+	prime64v4 uint64 = 9650029242287828579
+	prime64v5 uint64 = 2870177450012600261
+)
+
+func xxh64Round(acc, input uint64) uint64 {
+	acc += input * prime64v2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * prime64v1
+}
+
+func xxh64MergeRound(acc, val uint64) uint64 {
+	acc ^= xxh64Round(0, val)
+	return acc*prime64v1 + prime64v4
+}
+
+// Sum64 - returns xxHash64 of data with seed zero.
+func Sum64(data []byte) uint64 {
+	n := len(data)
+
+	var h uint64
+	if n >= 32 {
+		v1 := prime64v1 + prime64v2
+		v2 := prime64v2
+		v3 := uint64(0)
+		v4 := -prime64v1
+		for ; len(data) >= 32; data = data[32:] {
+			v1 = xxh64Round(v1, binary.LittleEndian.Uint64(data[0:8]))
+			v2 = xxh64Round(v2, binary.LittleEndian.Uint64(data[8:16]))
+			v3 = xxh64Round(v3, binary.LittleEndian.Uint64(data[16:24]))
+			v4 = xxh64Round(v4, binary.LittleEndian.Uint64(data[24:32]))
+		}
+
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) + bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		h = xxh64MergeRound(h, v1)
+		h = xxh64MergeRound(h, v2)
+		h = xxh64MergeRound(h, v3)
+		h = xxh64MergeRound(h, v4)
+	} else {
+		h = prime64v5
+	}
+
+	h += uint64(n)
+
+	for ; len(data) >= 8; data = data[8:] {
+		h ^= xxh64Round(0, binary.LittleEndian.Uint64(data[:8]))
+		h = bits.RotateLeft64(h, 27)*prime64v1 + prime64v4
+	}
+
+	if len(data) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(data[:4])) * prime64v1
+		h = bits.RotateLeft64(h, 23)*prime64v2 + prime64v3
+		data = data[4:]
+	}
+
+	for _, b := range data {
+		h ^= uint64(b) * prime64v5
+		h = bits.RotateLeft64(h, 11) * prime64v1
+	}
+
+	h ^= h >> 33
+	h *= prime64v2
+	h ^= h >> 29
+	h *= prime64v3
+	h ^= h >> 32
+
+	return h
+}
@@ -17,31 +17,100 @@
 package parquet
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
-	"strings"
 
 	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/parquet-go/encryption"
 	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
 )
 
-func getColumns(
-	rowGroup *parquet.RowGroup,
-	columnNames set.StringSet,
-	schemaElements []*parquet.SchemaElement,
-	getReaderFunc GetReaderFunc,
-) (nameColumnMap map[string]*column, err error) {
+// offsetReader - tracks file offset of data read from column chunk.
+type offsetReader struct {
+	*thrift.TBufferedTransport
+	offset int64
+}
+
+func (reader *offsetReader) Read(p []byte) (n int, err error) {
+	n, err = reader.TBufferedTransport.Read(p)
+	reader.offset += int64(n)
+	return n, err
+}
+
+func (reader *offsetReader) ReadByte() (b byte, err error) {
+	if b, err = reader.TBufferedTransport.ReadByte(); err == nil {
+		reader.offset++
+	}
+
+	return b, err
+}
+
+func newOffsetReader(rc io.Reader, offset, size int64) *offsetReader {
+	// Buffer is allocated upfront, hence it does not exceed readBufferSize for corrupt size.
+	bufferSize := size
+	if bufferSize > readBufferSize {
+		bufferSize = readBufferSize
+	}
+
+	return &offsetReader{
+		TBufferedTransport: thrift.NewTBufferedTransport(thrift.NewStreamTransportR(io.LimitReader(rc, size)), int(bufferSize)),
+		offset:             offset,
+	}
+}
+
+// columnOptions holds options of the reader which columns are read with.
+type columnOptions struct {
+	columnNames    set.StringSet
+	schemaElements []*parquet.SchemaElement
+	getReaderFunc  GetReaderFunc
+	verifyChecksum bool
+	decryptor      *fileDecryptor
+	lenient        bool
+	limits         *Limits
+	legacyLayout   bool // Data pages may be of layout written by releases before created_by was written.
+}
+
+// getColumns returns columns of row group to be read from row of index row in the row group. Pages
+// before the row are not read if column chunk has offset index.
+func getColumns(rowGroup *parquet.RowGroup, rowGroupIndex int, row, firstRow int64, opts *columnOptions) (nameColumnMap map[string]*column, err error) {
+	schemaElements := opts.schemaElements
+
+	// First element of []*parquet.SchemaElement from parquet file metadata is 'schema'
+	// which is always skipped, hence index + 1 is valid.
 	nameIndexMap := make(map[string]int)
+	for index, path := range schemaPaths(schemaElements) {
+		nameIndexMap[path] = index + 1
+	}
+
 	for colIndex, columnChunk := range rowGroup.GetColumns() {
+		columnName := schema.JoinPath(columnPath(columnChunk))
+		if opts.columnNames != nil && !opts.columnNames.Contains(columnName) {
+			continue
+		}
+
+		var columnDecryptor *pageDecryptor
+		if columnChunk.IsSetCryptoMetadata() {
+			cipher, err := opts.decryptor.columnCipher(columnChunk)
+			if err != nil {
+				return nil, fmt.Errorf("parquet: column %v: %w", columnName, err)
+			}
+
+			columnDecryptor = &pageDecryptor{
+				cipher:          cipher,
+				rowGroupOrdinal: int16(rowGroupIndex),
+				columnOrdinal:   int16(colIndex),
+				dictionaryPage:  columnChunk.GetMetaData().IsSetDictionaryPageOffset(),
+			}
+		}
+
 		meta := columnChunk.GetMetaData()
 		if meta == nil {
 			return nil, errors.New("parquet: column metadata missing")
 		}
-		columnName := strings.Join(meta.GetPathInSchema(), ".")
-		if columnNames != nil && !columnNames.Contains(columnName) {
-			continue
-		}
 
 		// Ignore column spanning into another file.
 		if columnChunk.GetFilePath() != "" {
@@ -57,41 +126,92 @@ func getColumns(
 		if size < 0 {
 			return nil, errors.New("parquet: negative compressed size")
 		}
-		rc, err := getReaderFunc(offset, size)
-		if err != nil {
-			return nil, err
+		endOffset := offset + size
+
+		var seek *pageSeek
+		if row > 0 {
+			var cipher *encryption.Cipher
+			if columnDecryptor != nil {
+				cipher = columnDecryptor.cipher
+			}
+
+			locations, err := readOffsetIndex(opts.getReaderFunc, columnChunk, cipher, int16(rowGroupIndex), int16(colIndex), opts.limits)
+			if err != nil {
+				return nil, fmt.Errorf("parquet: column %v: %w", columnName, err)
+			}
+
+			if seek, err = findPage(locations, row, offset, endOffset); err != nil {
+				return nil, fmt.Errorf("parquet: column %v: %w", columnName, err)
+			}
 		}
 
-		thriftReader := thrift.NewTBufferedTransport(thrift.NewStreamTransportR(rc), int(size))
+		var seekOffset int64
+		if seek != nil {
+			// Dictionary page is read before seeking to the page.
+			if meta.DictionaryPageOffset != nil {
+				seekOffset = seek.offset
+				size = seek.firstOffset - offset
+			} else {
+				offset = seek.offset
+				size = endOffset - offset
+			}
+
+			if columnDecryptor != nil {
+				columnDecryptor.pageOrdinal = int16(seek.index)
+			}
+		}
+
+		rc, err := opts.getReaderFunc(offset, size)
+		if err != nil {
+			return nil, &PageError{
+				Kind:       ErrTruncated,
+				Column:     columnName,
+				RowGroup:   rowGroupIndex,
+				PageOffset: offset,
+				Row:        firstRow,
+				Err:        err,
+			}
+		}
 
 		if nameColumnMap == nil {
 			nameColumnMap = make(map[string]*column)
 		}
 		var se *parquet.SchemaElement
-		for _, schema := range schemaElements {
-			if schema != nil && schema.Name == columnName {
-				se = schema
-				break
-			}
+		if index, ok := nameIndexMap[columnName]; ok {
+			se = schemaElements[index]
 		}
 
-		nameColumnMap[columnName] = &column{
+		col := &column{
 			name:           columnName,
+			rowGroupIndex:  rowGroupIndex,
 			metadata:       meta,
 			schema:         se,
 			schemaElements: schemaElements,
+			nameIndexMap:   nameIndexMap,
 			rc:             rc,
-			thriftReader:   thriftReader,
+			thriftReader:   newOffsetReader(rc, offset, size),
 			valueType:      meta.GetType(),
+			verifyChecksum: opts.verifyChecksum,
+			pageDecryptor:  columnDecryptor,
+
+			repeatedDefLevels: getRepeatedDefLevels(nameIndexMap, schemaElements, meta.GetPathInSchema()),
+			firstRow:          firstRow,
+			lenient:           opts.lenient,
+			limits:            opts.limits,
+			legacyLayout:      opts.legacyLayout,
+			getReaderFunc:     opts.getReaderFunc,
+			endOffset:         endOffset,
+			seekOffset:        seekOffset,
+			skipRows:          row,
 		}
 
-		// First element of []*parquet.SchemaElement from parquet file metadata is 'schema'
-		// which is always skipped, hence colIndex + 1 is valid.
-		nameIndexMap[columnName] = colIndex + 1
-	}
+		if seek != nil {
+			col.seeked = true
+			col.numRows = seek.firstRow
+			col.skipRows = row - seek.firstRow
+		}
 
-	for name := range nameColumnMap {
-		nameColumnMap[name].nameIndexMap = nameIndexMap
+		nameColumnMap[columnName] = col
 	}
 
 	return nameColumnMap, nil
@@ -99,6 +219,7 @@ func getColumns(
 
 type column struct {
 	name           string
+	rowGroupIndex  int
 	endOfValues    bool
 	valueIndex     int
 	valueType      parquet.Type
@@ -109,7 +230,27 @@ type column struct {
 	dictPage       *page
 	dataTable      *table
 	rc             io.ReadCloser
-	thriftReader   *thrift.TBufferedTransport
+	thriftReader   *offsetReader
+	verifyChecksum bool
+	pageDecryptor  *pageDecryptor
+	err            error
+
+	repeatedDefLevels []int32 // Definition levels of repeated elements in path of the column.
+	firstRow          int64   // Index of first row of the row group in the file.
+	numRows           int64   // Number of rows of pages read.
+	numValues         int64   // Number of values of pages read.
+	lenient           bool    // Skips pages which are not readable.
+	skipped           []error // Errors of skipped pages.
+	limits            *Limits
+	legacyLayout      bool
+
+	getReaderFunc GetReaderFunc
+	endOffset     int64 // File offset of end of the column chunk.
+	seekOffset    int64 // File offset of page to be read after dictionary page; zero if not seeking.
+	seeked        bool  // Whether pages are skipped by offset index, hence number of values of pages read is unknown.
+	skipRows      int64 // Number of rows to be skipped before first value is read.
+	pageOffset    int64 // File offset of first page of values in dataTable.
+	rowPageOffset int64 // File offset of page of first value of last row read.
 }
 
 func (column *column) close() (err error) {
@@ -121,42 +262,250 @@ func (column *column) close() (err error) {
 	return err
 }
 
-func (column *column) readPage() {
-	page, _, _, err := readPage(
-		column.thriftReader,
-		column.metadata,
-		column.nameIndexMap,
-		column.schemaElements,
-	)
+// readPage reads pages till a data page having values. Pages are read in a loop rather than recursively, hence
+// column chunk of many dictionary pages or data pages without values does not grow the stack.
+func (column *column) readPage(ctx context.Context) {
+	for {
+		if column.seeked {
+			if column.thriftReader.offset >= column.endOffset {
+				column.endOfValues = true
+			}
+		} else if column.numValues >= column.metadata.GetNumValues() {
+			column.endOfValues = true
+		}
+
+		if column.endOfValues {
+			return
+		}
+
+		pageOffset := column.thriftReader.offset
+		page, _, numRows, err := readPage(
+			ctx,
+			column.thriftReader,
+			column.metadata,
+			column.nameIndexMap,
+			column.schemaElements,
+			column.verifyChecksum,
+			column.pageDecryptor,
+			column.limits,
+			column.legacyLayout,
+		)
+
+		if err == nil && page.Header.GetType() != parquet.PageType_DICTIONARY_PAGE {
+			if err = page.decode(column.dictPage); err != nil {
+				err = &pageDataError{header: page.Header, err: err}
+			}
+		}
+
+		if err != nil {
+			column.readError(ctx, err, pageOffset)
+			return
+		}
+
+		if page.Header.GetType() == parquet.PageType_DICTIONARY_PAGE {
+			column.dictPage = page
+			if column.seekOffset > 0 {
+				if err = column.seekPage(); err != nil {
+					column.readError(ctx, err, column.seekOffset)
+					return
+				}
+			}
+
+			continue
+		}
+
+		column.numValues += int64(len(page.DataTable.Values))
+		column.numRows += numRows
+
+		if column.dataTable == nil {
+			column.dataTable = newTableFromTable(page.DataTable)
+			column.pageOffset = pageOffset
+		}
+
+		column.dataTable.Merge(page.DataTable)
+		if len(column.dataTable.Values) > 0 {
+			return
+		}
+		column.dataTable = nil
+	}
+}
+
+// readError sets error of reading page at pageOffset.
+func (column *column) readError(ctx context.Context, err error, pageOffset int64) {
+	if ctx.Err() != nil {
+		// Cancellation is not an error of the page even in lenient mode.
+		column.err = ctx.Err()
+		column.endOfValues = true
+		return
+	}
+
+	column.pageError(err, pageOffset)
+}
+
+// seekPage continues reading of column chunk from page at seekOffset after its dictionary page is read.
+func (column *column) seekPage() error {
+	column.close()
 
+	size := column.endOffset - column.seekOffset
+	rc, err := column.getReaderFunc(column.seekOffset, size)
 	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTruncated, err)
+	}
+
+	column.rc = rc
+	column.thriftReader = newOffsetReader(rc, column.seekOffset, size)
+	column.seekOffset = 0
+	return nil
+}
+
+// skip skips rows of the column. A row of repeated column ends before next value of repetition level 0.
+func (column *column) skip(ctx context.Context, rows int64) {
+	for {
+		if column.dataTable == nil {
+			column.readPage(ctx)
+			column.valueIndex = 0
+		}
+
+		if column.err != nil || column.endOfValues {
+			return
+		}
+
+		if len(column.repeatedDefLevels) == 0 {
+			if rows == 0 {
+				return
+			}
+
+			n := len(column.dataTable.Values) - column.valueIndex
+			if int64(n) > rows {
+				n = int(rows)
+			}
+
+			rows -= int64(n)
+			column.valueIndex += n
+		} else {
+			if column.dataTable.RepetitionLevels[column.valueIndex] == 0 {
+				if rows == 0 {
+					return
+				}
+				rows--
+			}
+
+			column.valueIndex++
+		}
+
+		if len(column.dataTable.Values) == column.valueIndex {
+			column.dataTable = nil
+		}
+	}
+}
+
+// pageError sets error of page at pageOffset. In lenient mode, the error is added to skipped errors and
+// the page is read as null values if number of its rows is known, otherwise rest of the column chunk is
+// read as null values.
+func (column *column) pageError(err error, pageOffset int64) {
+	var header *parquet.PageHeader
+	var dataErr *pageDataError
+	if errors.As(err, &dataErr) {
+		header = dataErr.header
+		err = dataErr.err
+	}
+
+	var checksumErr *ChecksumError
+	var decryptionErr *DecryptionError
+	switch {
+	case errors.As(err, &checksumErr):
+		checksumErr.Column = column.name
+		checksumErr.RowGroup = column.rowGroupIndex
+		checksumErr.PageOffset = pageOffset
+		err = checksumErr
+	case errors.As(err, &decryptionErr):
+		decryptionErr.Column = column.name
+		decryptionErr.RowGroup = column.rowGroupIndex
+		decryptionErr.PageOffset = pageOffset
+		err = decryptionErr
+	default:
+		kind := ErrCorruptPage
+		switch {
+		case errors.Is(err, ErrTruncated):
+			kind = ErrTruncated
+		case errors.Is(err, ErrUnsupportedEncoding):
+			kind = ErrUnsupportedEncoding
+		case errors.Is(err, ErrLimitExceeded):
+			kind = ErrLimitExceeded
+		}
+
+		err = &PageError{
+			Kind:       kind,
+			Column:     column.name,
+			RowGroup:   column.rowGroupIndex,
+			PageOffset: pageOffset,
+			Row:        column.firstRow + column.numRows,
+			Err:        err,
+		}
+	}
+
+	if !column.lenient {
+		column.err = err
 		column.endOfValues = true
 		return
 	}
 
-	if page.Header.GetType() == parquet.PageType_DICTIONARY_PAGE {
-		column.dictPage = page
-		column.readPage()
+	column.skipped = append(column.skipped, err)
+
+	var numValues, numRows int64
+	switch {
+	case header == nil || errors.Is(err, ErrTruncated):
+	case header.IsSetDataPageHeaderV2():
+		numValues = int64(header.DataPageHeaderV2.GetNumValues())
+		numRows = int64(header.DataPageHeaderV2.GetNumRows())
+	case header.IsSetDataPageHeader() && len(column.repeatedDefLevels) == 0:
+		numValues = int64(header.DataPageHeader.GetNumValues())
+		numRows = numValues
+	}
+
+	if numRows <= 0 {
+		column.endOfValues = true
 		return
 	}
 
-	page.decode(column.dictPage)
+	column.numValues += numValues
+	column.numRows += numRows
 
+	nullTable := &table{
+		Values:           make([]interface{}, numRows),
+		DefinitionLevels: make([]int32, numRows),
+		RepetitionLevels: make([]int32, numRows),
+	}
 	if column.dataTable == nil {
-		column.dataTable = newTableFromTable(page.DataTable)
+		column.dataTable = nullTable
+		column.pageOffset = pageOffset
+	} else {
+		column.dataTable.Merge(nullTable)
 	}
-
-	column.dataTable.Merge(page.DataTable)
 }
 
-func (column *column) read() (value interface{}, valueType parquet.Type, cnv *parquet.SchemaElement) {
+func (column *column) read(ctx context.Context) (value interface{}, valueType parquet.Type, cnv *parquet.SchemaElement, err error) {
+	if column.skipRows > 0 {
+		column.skip(ctx, column.skipRows)
+		column.skipRows = 0
+	}
+
 	if column.dataTable == nil {
-		column.readPage()
+		column.readPage(ctx)
 		column.valueIndex = 0
 	}
 
+	if column.err != nil {
+		return nil, column.metadata.GetType(), column.schema, column.err
+	}
+
 	if column.endOfValues {
-		return nil, column.metadata.GetType(), column.schema
+		return nil, column.metadata.GetType(), column.schema, nil
+	}
+
+	column.rowPageOffset = column.pageOffset
+	if len(column.repeatedDefLevels) > 0 {
+		return column.readRow(ctx)
 	}
 
 	value = column.dataTable.Values[column.valueIndex]
@@ -165,5 +514,47 @@ func (column *column) read() (value interface{}, valueType parquet.Type, cnv *pa
 		column.dataTable = nil
 	}
 
-	return value, column.metadata.GetType(), column.schema
+	return value, column.metadata.GetType(), column.schema, nil
+}
+
+// readRow reads values of a row of repeated column i.e. values till next repetition level 0, and
+// returns them as nested list.
+func (column *column) readRow(ctx context.Context) (value interface{}, valueType parquet.Type, cnv *parquet.SchemaElement, err error) {
+	var values []interface{}
+	var repetitionLevels, definitionLevels []int32
+	for {
+		if column.dataTable == nil {
+			column.readPage(ctx)
+			column.valueIndex = 0
+		}
+
+		if column.err != nil {
+			return nil, column.metadata.GetType(), column.schema, column.err
+		}
+
+		if column.endOfValues {
+			break
+		}
+
+		repetitionLevel := column.dataTable.RepetitionLevels[column.valueIndex]
+		if repetitionLevel == 0 && len(values) > 0 {
+			break
+		}
+
+		values = append(values, column.dataTable.Values[column.valueIndex])
+		repetitionLevels = append(repetitionLevels, repetitionLevel)
+		definitionLevels = append(definitionLevels, column.dataTable.DefinitionLevels[column.valueIndex])
+
+		column.valueIndex++
+		if len(column.dataTable.Values) == column.valueIndex {
+			column.dataTable = nil
+		}
+	}
+
+	if len(values) == 0 {
+		return nil, column.metadata.GetType(), column.schema, nil
+	}
+
+	value = assembleList(values, repetitionLevels, definitionLevels, column.repeatedDefLevels, 0)
+	return value, column.metadata.GetType(), column.schema, nil
 }
@@ -0,0 +1,413 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/bits"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// DefaultCompressionLevel denotes default compression level of any codec. It is out of range of levels of any codec,
+// hence e.g. gzip level 0 (no compression) can be requested.
+const DefaultCompressionLevel = math.MinInt32
+
+// Codec - compresses and uncompresses page data. Codec must be safe for concurrent use.
+type Codec interface {
+	// Compress compresses data with level. Level is codec specific and DefaultCompressionLevel chooses codec's default.
+	Compress(data []byte, level int) ([]byte, error)
+
+	// Uncompress uncompresses data.
+	Uncompress(data []byte) ([]byte, error)
+}
+
+// ErrUncompressedSizeLimit denotes uncompressed data is larger than the limit.
+var ErrUncompressedSizeLimit = errors.New("uncompressed size exceeds limit")
+
+// LimitedCodec - is implemented by codecs which stop uncompressing once uncompressed data exceeds a limit.
+type LimitedCodec interface {
+	Codec
+
+	// UncompressLimit uncompresses data. ErrUncompressedSizeLimit is returned if uncompressed data is larger than limit bytes.
+	UncompressLimit(data []byte, limit int64) ([]byte, error)
+}
+
+// readAllLimit reads all data from reader. Positive limit restricts read data to limit bytes.
+func readAllLimit(reader io.Reader, limit int64) ([]byte, error) {
+	if limit <= 0 {
+		return ioutil.ReadAll(reader)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > limit {
+		return nil, ErrUncompressedSizeLimit
+	}
+
+	return data, nil
+}
+
+var codecsMu sync.RWMutex
+var codecs = map[parquet.CompressionCodec]Codec{
+	parquet.CompressionCodec_UNCOMPRESSED: uncompressedCodec{},
+	parquet.CompressionCodec_SNAPPY:       snappyCodec{},
+	parquet.CompressionCodec_GZIP:         new(gzipCodec),
+	parquet.CompressionCodec_LZ4:          lz4Codec{},
+	parquet.CompressionCodec_ZSTD:         new(zstdCodec),
+	parquet.CompressionCodec_BROTLI:       new(brotliCodec),
+	parquet.CompressionCodec_LZ4_RAW:      lz4RawCodec{},
+}
+
+// RegisterCodec registers codec for compression type. Previously registered codec including builtin one is replaced.
+func RegisterCodec(compressionType parquet.CompressionCodec, codec Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+
+	if codec == nil {
+		delete(codecs, compressionType)
+		return
+	}
+
+	codecs[compressionType] = codec
+}
+
+// GetCodec returns registered codec of compression type.
+func GetCodec(compressionType parquet.CompressionCodec) (Codec, error) {
+	codecsMu.RLock()
+	codec, found := codecs[compressionType]
+	codecsMu.RUnlock()
+
+	if !found {
+		return nil, fmt.Errorf("unsupported compression codec %v", compressionType)
+	}
+
+	return codec, nil
+}
+
+type uncompressedCodec struct{}
+
+func (uncompressedCodec) Compress(data []byte, level int) ([]byte, error) {
+	return data, nil
+}
+
+func (uncompressedCodec) Uncompress(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+func (uncompressedCodec) UncompressLimit(data []byte, limit int64) ([]byte, error) {
+	if limit > 0 && int64(len(data)) > limit {
+		return nil, ErrUncompressedSizeLimit
+	}
+
+	return data, nil
+}
+
+type snappyCodec struct{}
+
+func (snappyCodec) Compress(data []byte, level int) ([]byte, error) {
+	return s2.EncodeSnappy(nil, data), nil
+}
+
+func (snappyCodec) Uncompress(data []byte) ([]byte, error) {
+	return s2.Decode(nil, data)
+}
+
+func (snappyCodec) UncompressLimit(data []byte, limit int64) ([]byte, error) {
+	if limit > 0 {
+		n, err := s2.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+
+		if int64(n) > limit {
+			return nil, ErrUncompressedSizeLimit
+		}
+	}
+
+	return s2.Decode(nil, data)
+}
+
+// levelPools holds a sync.Pool per compression level.
+type levelPools struct {
+	pools sync.Map
+	newf  func(level int) interface{}
+}
+
+func (lp *levelPools) get(level int) *sync.Pool {
+	if pool, found := lp.pools.Load(level); found {
+		return pool.(*sync.Pool)
+	}
+
+	pool, _ := lp.pools.LoadOrStore(level, &sync.Pool{
+		New: func() interface{} { return lp.newf(level) },
+	})
+	return pool.(*sync.Pool)
+}
+
+type gzipCodec struct {
+	writerPools levelPools
+	readerPool  sync.Pool
+	once        sync.Once
+}
+
+func (codec *gzipCodec) init() {
+	codec.once.Do(func() {
+		codec.writerPools.newf = func(level int) interface{} {
+			writer, err := gzip.NewWriterLevel(nil, level)
+			if err != nil {
+				return err
+			}
+			return writer
+		}
+	})
+}
+
+func (codec *gzipCodec) Compress(data []byte, level int) ([]byte, error) {
+	codec.init()
+
+	if level == DefaultCompressionLevel {
+		level = gzip.DefaultCompression
+	}
+
+	pool := codec.writerPools.get(level)
+	v := pool.Get()
+	if err, ok := v.(error); ok {
+		return nil, err
+	}
+	writer := v.(*gzip.Writer)
+	defer pool.Put(writer)
+
+	buf := new(bytes.Buffer)
+	writer.Reset(buf)
+	n, err := writer.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(data) {
+		return nil, fmt.Errorf("short writes")
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (codec *gzipCodec) Uncompress(data []byte) ([]byte, error) {
+	return codec.UncompressLimit(data, 0)
+}
+
+func (codec *gzipCodec) UncompressLimit(data []byte, limit int64) ([]byte, error) {
+	reader, ok := codec.readerPool.Get().(*gzip.Reader)
+	if ok {
+		if err := reader.Reset(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if reader, err = gzip.NewReader(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+	}
+	defer codec.readerPool.Put(reader)
+
+	result, err := readAllLimit(reader, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, reader.Close()
+}
+
+type zstdCodec struct {
+	encoders sync.Map
+	decoders sync.Map // Decoders of uncompressed size limits rounded up to power of two.
+	decoder  *zstd.Decoder
+	once     sync.Once
+	err      error
+}
+
+func (codec *zstdCodec) init() error {
+	codec.once.Do(func() {
+		codec.decoder, codec.err = zstd.NewReader(nil)
+	})
+
+	return codec.err
+}
+
+// encoder returns encoder of level. zstd.Encoder.EncodeAll is safe for concurrent use, hence one encoder per level is shared.
+func (codec *zstdCodec) encoder(level int) (*zstd.Encoder, error) {
+	if encoder, found := codec.encoders.Load(level); found {
+		return encoder.(*zstd.Encoder), nil
+	}
+
+	encoderLevel := zstd.SpeedDefault
+	if level != DefaultCompressionLevel {
+		encoderLevel = zstd.EncoderLevelFromZstd(level)
+	}
+
+	encoder, err := zstd.NewWriter(nil, zstd.WithZeroFrames(true), zstd.WithEncoderLevel(encoderLevel))
+	if err != nil {
+		return nil, err
+	}
+
+	if v, loaded := codec.encoders.LoadOrStore(level, encoder); loaded {
+		encoder.Close()
+		return v.(*zstd.Encoder), nil
+	}
+
+	return encoder, nil
+}
+
+func (codec *zstdCodec) Compress(data []byte, level int) ([]byte, error) {
+	encoder, err := codec.encoder(level)
+	if err != nil {
+		return nil, err
+	}
+
+	return encoder.EncodeAll(data, nil), nil
+}
+
+func (codec *zstdCodec) Uncompress(data []byte) ([]byte, error) {
+	if err := codec.init(); err != nil {
+		return nil, err
+	}
+
+	return codec.decoder.DecodeAll(data, nil)
+}
+
+// limitedDecoder returns decoder restricting uncompressed data to limit bytes rounded up to power of two, hence at most
+// one decoder per bit of limit is kept. zstd.Decoder.DecodeAll is safe for concurrent use, hence decoders are shared.
+func (codec *zstdCodec) limitedDecoder(limit int64) (*zstd.Decoder, error) {
+	maxMemory := uint64(1) << uint(bits.Len64(uint64(limit-1)))
+	if decoder, found := codec.decoders.Load(maxMemory); found {
+		return decoder.(*zstd.Decoder), nil
+	}
+
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxMemory))
+	if err != nil {
+		return nil, err
+	}
+
+	if v, loaded := codec.decoders.LoadOrStore(maxMemory, decoder); loaded {
+		decoder.Close()
+		return v.(*zstd.Decoder), nil
+	}
+
+	return decoder, nil
+}
+
+func (codec *zstdCodec) UncompressLimit(data []byte, limit int64) ([]byte, error) {
+	if limit <= 0 {
+		return codec.Uncompress(data)
+	}
+
+	decoder, err := codec.limitedDecoder(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Window larger than limit is also rejected as decoder restricts its memory to limit bytes rounded up.
+	result, err := decoder.DecodeAll(data, nil)
+	if errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) {
+		return nil, ErrUncompressedSizeLimit
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(result)) > limit {
+		return nil, ErrUncompressedSizeLimit
+	}
+
+	return result, nil
+}
+
+type brotliCodec struct {
+	writerPools levelPools
+	readerPool  sync.Pool
+	once        sync.Once
+}
+
+func (codec *brotliCodec) init() {
+	codec.once.Do(func() {
+		codec.writerPools.newf = func(level int) interface{} {
+			return brotli.NewWriterLevel(nil, level)
+		}
+	})
+}
+
+func (codec *brotliCodec) Compress(data []byte, level int) ([]byte, error) {
+	codec.init()
+
+	if level == DefaultCompressionLevel {
+		level = brotli.DefaultCompression
+	}
+
+	pool := codec.writerPools.get(level)
+	writer := pool.Get().(*brotli.Writer)
+	defer pool.Put(writer)
+
+	buf := new(bytes.Buffer)
+	writer.Reset(buf)
+	n, err := writer.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(data) {
+		return nil, fmt.Errorf("short writes")
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (codec *brotliCodec) Uncompress(data []byte) ([]byte, error) {
+	return codec.UncompressLimit(data, 0)
+}
+
+func (codec *brotliCodec) UncompressLimit(data []byte, limit int64) ([]byte, error) {
+	reader, ok := codec.readerPool.Get().(*brotli.Reader)
+	if ok {
+		if err := reader.Reset(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+	} else {
+		reader = brotli.NewReader(bytes.NewReader(data))
+	}
+	defer codec.readerPool.Put(reader)
+
+	return readAllLimit(reader, limit)
+}
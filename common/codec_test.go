@@ -0,0 +1,251 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+type reverseCodec struct{}
+
+func (reverseCodec) Compress(data []byte, level int) ([]byte, error) {
+	result := make([]byte, len(data))
+	for i := range data {
+		result[len(data)-1-i] = data[i]
+	}
+	return result, nil
+}
+
+func (codec reverseCodec) Uncompress(data []byte) ([]byte, error) {
+	return codec.Compress(data, DefaultCompressionLevel)
+}
+
+func TestCompressLevel(t *testing.T) {
+	data := bytes.Repeat([]byte("compression level "), 1000)
+
+	testCases := []struct {
+		compressionType parquet.CompressionCodec
+		level           int
+	}{
+		{parquet.CompressionCodec_GZIP, DefaultCompressionLevel},
+		{parquet.CompressionCodec_GZIP, 0},
+		{parquet.CompressionCodec_GZIP, 1},
+		{parquet.CompressionCodec_GZIP, 9},
+		{parquet.CompressionCodec_ZSTD, DefaultCompressionLevel},
+		{parquet.CompressionCodec_ZSTD, 1},
+		{parquet.CompressionCodec_ZSTD, 19},
+		{parquet.CompressionCodec_BROTLI, DefaultCompressionLevel},
+		{parquet.CompressionCodec_BROTLI, 0},
+		{parquet.CompressionCodec_BROTLI, 11},
+		{parquet.CompressionCodec_LZ4, 9},
+		{parquet.CompressionCodec_LZ4_RAW, 9},
+	}
+
+	for i, testCase := range testCases {
+		// Compress twice to use pooled encoders and decoders.
+		for j := 0; j < 2; j++ {
+			compressed, err := CompressLevel(testCase.compressionType, data, testCase.level)
+			if err != nil {
+				t.Fatalf("case %v: %v", i+1, err)
+			}
+
+			result, err := Uncompress(testCase.compressionType, compressed)
+			if err != nil {
+				t.Fatalf("case %v: %v", i+1, err)
+			}
+
+			if !bytes.Equal(result, data) {
+				t.Fatalf("case %v: uncompressed data mismatch", i+1)
+			}
+		}
+	}
+}
+
+func TestCompressLevelZero(t *testing.T) {
+	// gzip level 0 stores data, unlike default level.
+	data := bytes.Repeat([]byte("compression level "), 1000)
+
+	stored, err := CompressLevel(parquet.CompressionCodec_GZIP, data, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compressed, err := Compress(parquet.CompressionCodec_GZIP, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stored) <= len(data) || len(compressed) >= len(data) {
+		t.Fatalf("size: data: %v, level 0: %v, default level: %v", len(data), len(stored), len(compressed))
+	}
+}
+
+func TestRegisterCodec(t *testing.T) {
+	if _, err := Compress(parquet.CompressionCodec_LZO, []byte("foo")); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+
+	RegisterCodec(parquet.CompressionCodec_LZO, reverseCodec{})
+	defer RegisterCodec(parquet.CompressionCodec_LZO, nil)
+
+	compressed, err := Compress(parquet.CompressionCodec_LZO, []byte("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(compressed) != "oof" {
+		t.Fatalf("expected: oof, got: %s", compressed)
+	}
+
+	result, err := Uncompress(parquet.CompressionCodec_LZO, compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(result) != "foo" {
+		t.Fatalf("expected: foo, got: %s", result)
+	}
+}
+
+func TestUncompressLimit(t *testing.T) {
+	data := bytes.Repeat([]byte("uncompress limit "), 1000)
+
+	testCases := []parquet.CompressionCodec{
+		parquet.CompressionCodec_UNCOMPRESSED,
+		parquet.CompressionCodec_SNAPPY,
+		parquet.CompressionCodec_GZIP,
+		parquet.CompressionCodec_LZ4,
+		parquet.CompressionCodec_ZSTD,
+		parquet.CompressionCodec_BROTLI,
+		parquet.CompressionCodec_LZ4_RAW,
+		parquet.CompressionCodec_LZO,
+	}
+
+	RegisterCodec(parquet.CompressionCodec_LZO, reverseCodec{})
+	defer RegisterCodec(parquet.CompressionCodec_LZO, nil)
+
+	for i, testCase := range testCases {
+		compressed, err := Compress(testCase, data)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		result, err := UncompressLimit(testCase, compressed, int64(len(data)))
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if !bytes.Equal(result, data) {
+			t.Fatalf("case %v: uncompressed data mismatch", i+1)
+		}
+
+		if _, err = UncompressLimit(testCase, compressed, int64(len(data)-1)); err != ErrUncompressedSizeLimit {
+			t.Fatalf("case %v: err: expected: %v, got: %v", i+1, ErrUncompressedSizeLimit, err)
+		}
+	}
+}
+
+func TestZstdLimitedDecoders(t *testing.T) {
+	data := bytes.Repeat([]byte("uncompress limit "), 1000)
+
+	codec := new(zstdCodec)
+	compressed, err := codec.Compress(data, DefaultCompressionLevel)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Limits 17000 to 17999 share decoder of limit 32768.
+	for limit := int64(17000); limit < 18000; limit++ {
+		result, err := codec.UncompressLimit(compressed, limit)
+		if limit < int64(len(data)) {
+			if err != ErrUncompressedSizeLimit {
+				t.Fatalf("limit %v: err: expected: %v, got: %v", limit, ErrUncompressedSizeLimit, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("limit %v: %v", limit, err)
+		}
+		if !bytes.Equal(result, data) {
+			t.Fatalf("limit %v: uncompressed data mismatch", limit)
+		}
+	}
+
+	var decoders []interface{}
+	codec.decoders.Range(func(key, value interface{}) bool {
+		decoders = append(decoders, key)
+		return true
+	})
+
+	if !reflect.DeepEqual(decoders, []interface{}{uint64(32768)}) {
+		t.Fatalf("decoders: expected: [32768], got: %v", decoders)
+	}
+}
+
+func TestLZ4HadoopCompress(t *testing.T) {
+	testCases := []struct {
+		data           []byte
+		expectedBlocks []int
+	}{
+		{[]byte{}, nil},
+		{[]byte("a"), []int{1}},
+		{bytes.Repeat([]byte("hadoop lz4 "), 100), []int{1100}},
+		{bytes.Repeat([]byte{1, 2, 3}, lz4HadoopBlockSize), []int{lz4HadoopBlockSize, lz4HadoopBlockSize, lz4HadoopBlockSize}},
+	}
+
+	for i, testCase := range testCases {
+		result, err := Compress(parquet.CompressionCodec_LZ4, testCase.data)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		// Every block is <uncompressed size><compressed size><LZ4 block> in big endian.
+		var blocks []int
+		for data := result; len(data) > 0; {
+			uncompressedSize := int(binary.BigEndian.Uint32(data))
+			compressedSize := int(binary.BigEndian.Uint32(data[4:]))
+			block, err := lz4RawUncompress(data[8:8+compressedSize], 0)
+			if err != nil {
+				t.Fatalf("case %v: block %v: %v", i+1, len(blocks)+1, err)
+			}
+			if len(block) != uncompressedSize {
+				t.Fatalf("case %v: block %v: size: expected: %v, got: %v", i+1, len(blocks)+1, uncompressedSize, len(block))
+			}
+
+			blocks = append(blocks, uncompressedSize)
+			data = data[8+compressedSize:]
+		}
+
+		if !reflect.DeepEqual(blocks, testCase.expectedBlocks) {
+			t.Fatalf("case %v: blocks: expected: %v, got: %v", i+1, testCase.expectedBlocks, blocks)
+		}
+
+		data, err := Uncompress(parquet.CompressionCodec_LZ4, result)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		if !bytes.Equal(data, testCase.data) {
+			t.Fatalf("case %v: uncompressed data mismatch", i+1)
+		}
+	}
+}
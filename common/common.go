@@ -1,14 +1,9 @@
 package common
 
 import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io/ioutil"
+	"hash/crc32"
 
-	"github.com/klauspost/compress/s2"
 	"github.com/minio/parquet-go/gen-go/parquet"
-	"github.com/pierrec/lz4"
 )
 
 // ToSliceValue converts values to a slice value.
@@ -44,7 +39,7 @@ func ToSliceValue(values []interface{}, parquetType parquet.Type) interface{} {
 			f64s[i] = values[i].(float64)
 		}
 		return f64s
-	case parquet.Type_BYTE_ARRAY:
+	case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
 		array := make([][]byte, len(values))
 		for i := range values {
 			array[i] = values[i].([]byte)
@@ -64,81 +59,66 @@ func BitWidth(ui64 uint64) (width int32) {
 	return width
 }
 
-// Compress compresses given data.
+// Compress compresses given data using default compression level of the codec.
 func Compress(compressionType parquet.CompressionCodec, data []byte) ([]byte, error) {
-	switch compressionType {
-	case parquet.CompressionCodec_UNCOMPRESSED:
-		return data, nil
-
-	case parquet.CompressionCodec_SNAPPY:
-		return s2.EncodeSnappy(nil, data), nil
-
-	case parquet.CompressionCodec_GZIP:
-		buf := new(bytes.Buffer)
-		writer := gzip.NewWriter(buf)
-		n, err := writer.Write(data)
-		if err != nil {
-			return nil, err
-		}
-		if n != len(data) {
-			return nil, fmt.Errorf("short writes")
-		}
-
-		if err = writer.Flush(); err != nil {
-			return nil, err
-		}
+	return CompressLevel(compressionType, data, DefaultCompressionLevel)
+}
 
-		if err = writer.Close(); err != nil {
-			return nil, err
-		}
+// CompressLevel compresses given data using level.
+func CompressLevel(compressionType parquet.CompressionCodec, data []byte, level int) ([]byte, error) {
+	codec, err := GetCodec(compressionType)
+	if err != nil {
+		return nil, err
+	}
 
-		return buf.Bytes(), nil
+	return codec.Compress(data, level)
+}
 
-	case parquet.CompressionCodec_LZ4:
-		buf := new(bytes.Buffer)
-		writer := lz4.NewWriter(buf)
-		n, err := writer.Write(data)
-		if err != nil {
-			return nil, err
-		}
-		if n != len(data) {
-			return nil, fmt.Errorf("short writes")
-		}
+// Uncompress uncompresses given data.
+func Uncompress(compressionType parquet.CompressionCodec, data []byte) ([]byte, error) {
+	codec, err := GetCodec(compressionType)
+	if err != nil {
+		return nil, err
+	}
 
-		if err = writer.Flush(); err != nil {
-			return nil, err
-		}
+	return codec.Uncompress(data)
+}
 
-		if err = writer.Close(); err != nil {
-			return nil, err
-		}
+// UncompressLimit uncompresses given data. Positive limit restricts uncompressed data to limit bytes; codecs not
+// implementing LimitedCodec uncompress whole data before it is checked.
+func UncompressLimit(compressionType parquet.CompressionCodec, data []byte, limit int64) ([]byte, error) {
+	codec, err := GetCodec(compressionType)
+	if err != nil {
+		return nil, err
+	}
 
-		return buf.Bytes(), nil
+	if limit <= 0 {
+		return codec.Uncompress(data)
 	}
 
-	return nil, fmt.Errorf("unsupported compression codec %v", compressionType)
-}
+	if limitedCodec, ok := codec.(LimitedCodec); ok {
+		return limitedCodec.UncompressLimit(data, limit)
+	}
 
-// Uncompress uncompresses given data.
-func Uncompress(compressionType parquet.CompressionCodec, data []byte) ([]byte, error) {
-	switch compressionType {
-	case parquet.CompressionCodec_UNCOMPRESSED:
-		return data, nil
+	result, err := codec.Uncompress(data)
+	if err != nil {
+		return nil, err
+	}
 
-	case parquet.CompressionCodec_SNAPPY:
-		return s2.Decode(nil, data)
+	if int64(len(result)) > limit {
+		return nil, ErrUncompressedSizeLimit
+	}
 
-	case parquet.CompressionCodec_GZIP:
-		reader, err := gzip.NewReader(bytes.NewReader(data))
-		if err != nil {
-			return nil, err
-		}
-		defer reader.Close()
-		return ioutil.ReadAll(reader)
+	return result, nil
+}
 
-	case parquet.CompressionCodec_LZ4:
-		return ioutil.ReadAll(lz4.NewReader(bytes.NewReader(data)))
+// PageChecksum returns CRC32 checksum of page data for PageHeader.Crc.
+func PageChecksum(data ...[]byte) *int32 {
+	var crc uint32
+	for _, b := range data {
+		crc = crc32.Update(crc, crc32.IEEETable, b)
 	}
 
-	return nil, fmt.Errorf("unsupported compression codec %v", compressionType)
+	checksum := int32(crc)
+	return &checksum
 }
@@ -0,0 +1,208 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/pierrec/lz4"
+)
+
+// lz4FrameMagic is the little endian magic number starting every LZ4 frame.
+var lz4FrameMagic = []byte{0x04, 0x22, 0x4d, 0x18}
+
+// lz4MaxRatio is the maximum ratio LZ4 block format can compress data with.
+const lz4MaxRatio = 255
+
+// lz4HadoopBlockSize is the default buffer size of Hadoop's Lz4Codec, hence
+// the maximum uncompressed size of a block it reads in one go.
+const lz4HadoopBlockSize = 256 << 10
+
+var errLZ4HadoopFormat = errors.New("parquet: invalid hadoop lz4 data")
+
+// lz4RawCompress encodes data in LZ4 block format. Positive level uses high compression mode with level as search depth.
+func lz4RawCompress(data []byte, level int) ([]byte, error) {
+	buf := make([]byte, lz4.CompressBlockBound(len(data)))
+
+	var n int
+	var err error
+	if level > 0 {
+		n, err = lz4.CompressBlockHC(data, buf, level)
+	} else {
+		n, err = lz4.CompressBlock(data, buf, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buf[:n], nil
+}
+
+// lz4RawUncompress decodes LZ4 block format data. As the block format does
+// not carry uncompressed size, output buffer grows until data fits in it.
+// Positive limit restricts the output buffer to limit bytes.
+func lz4RawUncompress(data []byte, limit int64) ([]byte, error) {
+	if len(data) == 0 {
+		return []byte{}, nil
+	}
+
+	maxSize := lz4MaxRatio * len(data)
+	if limit > 0 && int64(maxSize) > limit {
+		maxSize = int(limit)
+	}
+
+	size := 4 * len(data)
+	if size > maxSize {
+		size = maxSize
+	}
+
+	for {
+		buf := make([]byte, size)
+		n, err := lz4.UncompressBlock(data, buf)
+		if err == nil {
+			return buf[:n], nil
+		}
+
+		if size >= maxSize {
+			if maxSize < lz4MaxRatio*len(data) {
+				return nil, ErrUncompressedSizeLimit
+			}
+			return nil, err
+		}
+
+		if size *= 2; size > maxSize {
+			size = maxSize
+		}
+	}
+}
+
+// lz4HadoopCompress encodes data in format of Hadoop's Lz4Codec, which is
+// read by parquet-mr and Arrow for LZ4 codec. Each block of up to
+// lz4HadoopBlockSize bytes is written as a single LZ4 block.
+func lz4HadoopCompress(data []byte, level int) ([]byte, error) {
+	var result []byte
+	for len(data) > 0 {
+		size := len(data)
+		if size > lz4HadoopBlockSize {
+			size = lz4HadoopBlockSize
+		}
+
+		block, err := lz4RawCompress(data[:size], level)
+		if err != nil {
+			return nil, err
+		}
+
+		header := make([]byte, 8)
+		binary.BigEndian.PutUint32(header, uint32(size))
+		binary.BigEndian.PutUint32(header[4:], uint32(len(block)))
+		result = append(result, header...)
+		result = append(result, block...)
+		data = data[size:]
+	}
+
+	return result, nil
+}
+
+// lz4HadoopUncompress decodes data written by Hadoop's Lz4Codec which is
+// used by parquet-mr for LZ4 codec. Data is sequence of blocks where each
+// block is
+//
+//	<4 bytes big endian uncompressed size>
+//	<4 bytes big endian compressed size>
+//	<compressed size bytes of LZ4 block>
+//
+// Positive limit restricts uncompressed data to limit bytes.
+func lz4HadoopUncompress(data []byte, limit int64) (result []byte, err error) {
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return nil, errLZ4HadoopFormat
+		}
+
+		uncompressedSize := binary.BigEndian.Uint32(data)
+		compressedSize := binary.BigEndian.Uint32(data[4:])
+		data = data[8:]
+		if uint64(compressedSize) > uint64(len(data)) ||
+			uint64(uncompressedSize) > uint64(compressedSize)*lz4MaxRatio {
+			return nil, errLZ4HadoopFormat
+		}
+
+		if limit > 0 && int64(len(result))+int64(uncompressedSize) > limit {
+			return nil, ErrUncompressedSizeLimit
+		}
+
+		buf := make([]byte, uncompressedSize)
+		n, err := lz4.UncompressBlock(data[:compressedSize], buf)
+		if err != nil || n != int(uncompressedSize) {
+			return nil, errLZ4HadoopFormat
+		}
+
+		result = append(result, buf...)
+		data = data[compressedSize:]
+	}
+
+	return result, nil
+}
+
+// lz4Uncompress decodes data of LZ4 codec. Writers do not agree on LZ4
+// codec format, hence format is detected in below order.
+// 1. LZ4 frame format written by older versions of this package.
+// 2. Hadoop format written by parquet-mr and Arrow.
+// 3. LZ4 block format written by older versions of Arrow.
+// Positive limit restricts uncompressed data to limit bytes.
+func lz4Uncompress(data []byte, limit int64) ([]byte, error) {
+	if bytes.HasPrefix(data, lz4FrameMagic) {
+		return readAllLimit(lz4.NewReader(bytes.NewReader(data)), limit)
+	}
+
+	result, err := lz4HadoopUncompress(data, limit)
+	if err == nil || err == ErrUncompressedSizeLimit {
+		return result, err
+	}
+
+	return lz4RawUncompress(data, limit)
+}
+
+// lz4Codec writes Hadoop format as parquet-mr does; see lz4Uncompress for formats it reads.
+type lz4Codec struct{}
+
+func (lz4Codec) Compress(data []byte, level int) ([]byte, error) {
+	return lz4HadoopCompress(data, level)
+}
+
+func (lz4Codec) Uncompress(data []byte) ([]byte, error) {
+	return lz4Uncompress(data, 0)
+}
+
+func (lz4Codec) UncompressLimit(data []byte, limit int64) ([]byte, error) {
+	return lz4Uncompress(data, limit)
+}
+
+type lz4RawCodec struct{}
+
+func (lz4RawCodec) Compress(data []byte, level int) ([]byte, error) {
+	return lz4RawCompress(data, level)
+}
+
+func (lz4RawCodec) Uncompress(data []byte) ([]byte, error) {
+	return lz4RawUncompress(data, 0)
+}
+
+func (lz4RawCodec) UncompressLimit(data []byte, limit int64) ([]byte, error) {
+	return lz4RawUncompress(data, limit)
+}
@@ -17,111 +17,38 @@
 package parquet
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
-	"io/ioutil"
-	"sync"
 
-	"github.com/klauspost/compress/gzip"
-	"github.com/klauspost/compress/s2"
-	"github.com/klauspost/compress/zstd"
+	"github.com/minio/parquet-go/common"
 	"github.com/minio/parquet-go/gen-go/parquet"
-	"github.com/pierrec/lz4"
 )
 
-type compressionCodec parquet.CompressionCodec
-
-var zstdOnce sync.Once
-var zstdEnc *zstd.Encoder
-var zstdDec *zstd.Decoder
-
-func initZstd() {
-	zstdOnce.Do(func() {
-		zstdEnc, _ = zstd.NewWriter(nil, zstd.WithZeroFrames(true))
-		zstdDec, _ = zstd.NewReader(nil)
-	})
+// RegisterCodec - registers codec for compression type used by both Reader and Writer. Builtin codec of compression type is replaced.
+func RegisterCodec(compressionType parquet.CompressionCodec, codec common.Codec) {
+	common.RegisterCodec(compressionType, codec)
 }
 
-func (c compressionCodec) compress(buf []byte) ([]byte, error) {
-	switch parquet.CompressionCodec(c) {
-	case parquet.CompressionCodec_UNCOMPRESSED:
-		return buf, nil
-
-	case parquet.CompressionCodec_SNAPPY:
-		return s2.EncodeSnappy(nil, buf), nil
-
-	case parquet.CompressionCodec_GZIP:
-		byteBuf := new(bytes.Buffer)
-		writer := gzip.NewWriter(byteBuf)
-		n, err := writer.Write(buf)
-		if err != nil {
-			return nil, err
-		}
-		if n != len(buf) {
-			return nil, fmt.Errorf("short writes")
-		}
-
-		if err = writer.Flush(); err != nil {
-			return nil, err
-		}
-
-		if err = writer.Close(); err != nil {
-			return nil, err
-		}
-
-		return byteBuf.Bytes(), nil
-
-	case parquet.CompressionCodec_LZ4:
-		byteBuf := new(bytes.Buffer)
-		writer := lz4.NewWriter(byteBuf)
-		n, err := writer.Write(buf)
-		if err != nil {
-			return nil, err
-		}
-		if n != len(buf) {
-			return nil, fmt.Errorf("short writes")
-		}
-
-		if err = writer.Flush(); err != nil {
-			return nil, err
-		}
-
-		if err = writer.Close(); err != nil {
-			return nil, err
-		}
-
-		return byteBuf.Bytes(), nil
-	case parquet.CompressionCodec_ZSTD:
-		initZstd()
-		return zstdEnc.EncodeAll(buf, nil), nil
-	}
+type compressionCodec parquet.CompressionCodec
 
-	return nil, fmt.Errorf("invalid compression codec %v", c)
+func (c compressionCodec) compress(buf []byte) ([]byte, error) {
+	return common.Compress(parquet.CompressionCodec(c), buf)
 }
 
 func (c compressionCodec) uncompress(buf []byte) ([]byte, error) {
-	switch parquet.CompressionCodec(c) {
-	case parquet.CompressionCodec_UNCOMPRESSED:
-		return buf, nil
-
-	case parquet.CompressionCodec_SNAPPY:
-		return s2.Decode(nil, buf)
-
-	case parquet.CompressionCodec_GZIP:
-		reader, err := gzip.NewReader(bytes.NewReader(buf))
-		if err != nil {
-			return nil, err
-		}
-		defer reader.Close()
-		return ioutil.ReadAll(reader)
+	return c.uncompressLimit(buf, 0)
+}
 
-	case parquet.CompressionCodec_LZ4:
-		return ioutil.ReadAll(lz4.NewReader(bytes.NewReader(buf)))
+// uncompressLimit uncompresses buf. Positive limit restricts uncompressed data to limit bytes.
+func (c compressionCodec) uncompressLimit(buf []byte, limit int64) ([]byte, error) {
+	if _, err := common.GetCodec(parquet.CompressionCodec(c)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedEncoding, err)
+	}
 
-	case parquet.CompressionCodec_ZSTD:
-		initZstd()
-		return zstdDec.DecodeAll(buf, nil)
+	data, err := common.UncompressLimit(parquet.CompressionCodec(c), buf, limit)
+	if errors.Is(err, common.ErrUncompressedSizeLimit) {
+		return nil, fmt.Errorf("%w: uncompressed page size exceeds %v", ErrLimitExceeded, limit)
 	}
 
-	return nil, fmt.Errorf("invalid compression codec %v", c)
+	return data, err
 }
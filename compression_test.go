@@ -0,0 +1,95 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/pierrec/lz4"
+)
+
+func TestCompressionCodec(t *testing.T) {
+	data := bytes.Repeat([]byte("parquet-go compression codec "), 100)
+
+	testCases := []parquet.CompressionCodec{
+		parquet.CompressionCodec_UNCOMPRESSED,
+		parquet.CompressionCodec_SNAPPY,
+		parquet.CompressionCodec_GZIP,
+		parquet.CompressionCodec_LZ4,
+		parquet.CompressionCodec_ZSTD,
+		parquet.CompressionCodec_BROTLI,
+		parquet.CompressionCodec_LZ4_RAW,
+	}
+
+	for i, testCase := range testCases {
+		compressed, err := compressionCodec(testCase).compress(data)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		result, err := compressionCodec(testCase).uncompress(compressed)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if !bytes.Equal(result, data) {
+			t.Fatalf("case %v: %v: uncompressed data mismatch", i+1, testCase)
+		}
+	}
+}
+
+func TestCompressionCodecLZ4Hadoop(t *testing.T) {
+	data := bytes.Repeat([]byte("hadoop lz4 "), 100)
+
+	block := make([]byte, lz4.CompressBlockBound(len(data)))
+	n, err := lz4.CompressBlock(data, block, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block = block[:n]
+
+	var hadoopData []byte
+	for i := 0; i < 2; i++ {
+		header := make([]byte, 8)
+		binary.BigEndian.PutUint32(header, uint32(len(data)))
+		binary.BigEndian.PutUint32(header[4:], uint32(len(block)))
+		hadoopData = append(hadoopData, header...)
+		hadoopData = append(hadoopData, block...)
+	}
+
+	testCases := []struct {
+		data           []byte
+		expectedResult []byte
+	}{
+		{hadoopData, append(append([]byte{}, data...), data...)},
+		{block, data},
+	}
+
+	for i, testCase := range testCases {
+		result, err := compressionCodec(parquet.CompressionCodec_LZ4).uncompress(testCase.data)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if !bytes.Equal(result, testCase.expectedResult) {
+			t.Fatalf("case %v: uncompressed data mismatch", i+1)
+		}
+	}
+}
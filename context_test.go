@@ -0,0 +1,375 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+type contextKey struct{}
+
+func TestReaderContext(t *testing.T) {
+	data := writePageErrorFile(t)
+	getReaderFunc := bytesGetReaderFunc(data)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var requests int
+	getReaderFuncContext := func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+		requests++
+		if ctx.Value(contextKey{}) == nil {
+			t.Fatalf("request %v: context is not passed", requests)
+		}
+		return getReaderFunc(offset, length)
+	}
+
+	if _, err := NewReaderContext(cancelled, getReaderFuncContext, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err: expected: %v, got: %v", context.Canceled, err)
+	}
+	if requests != 0 {
+		t.Fatalf("requests: expected: 0, got: %v", requests)
+	}
+
+	ctx := context.WithValue(context.Background(), contextKey{}, true)
+	reader, err := NewReaderContext(ctx, getReaderFuncContext, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	var count int
+	for {
+		_, err := reader.ReadContext(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+	if count != 4 {
+		t.Fatalf("records: expected: 4, got: %v", count)
+	}
+
+	if _, err = reader.ReadContext(cancelled); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err: expected: %v, got: %v", context.Canceled, err)
+	}
+}
+
+func TestReaderContextCancel(t *testing.T) {
+	data := writePageErrorFile(t)
+	getReaderFunc := bytesGetReaderFunc(data)
+
+	testCases := []struct {
+		lenient bool
+	}{
+		{false},
+		{true},
+	}
+
+	for i, testCase := range testCases {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// Cancels while column chunk is being fetched.
+		getReaderFuncContext := func(requestCtx context.Context, offset, length int64) (io.ReadCloser, error) {
+			if offset >= 0 {
+				cancel()
+			}
+			return getReaderFunc(offset, length)
+		}
+
+		reader, err := NewReaderContext(context.Background(), getReaderFuncContext, nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		reader.Lenient = testCase.lenient
+
+		if _, err = reader.ReadContext(ctx); !errors.Is(err, context.Canceled) {
+			t.Fatalf("case %v: err: expected: %v, got: %v", i+1, context.Canceled, err)
+		}
+
+		if skipped := reader.SkippedPages(); len(skipped) != 0 {
+			t.Fatalf("case %v: skipped pages: expected: 0, got: %v", i+1, len(skipped))
+		}
+
+		// The record is read again by next call.
+		record, err := reader.ReadContext(context.Background())
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		if value, _ := record.Get("a"); value.Value != int32(1) {
+			t.Fatalf("case %v: expected: 1, got: %v", i+1, value.Value)
+		}
+
+		reader.Close()
+	}
+}
+
+// blockingReadCloser - blocks reads till context of its request is done if blocking is set.
+type blockingReadCloser struct {
+	io.ReadCloser
+	ctx      context.Context
+	blocking *bool
+}
+
+func (rc *blockingReadCloser) Read(p []byte) (n int, err error) {
+	if *rc.blocking {
+		<-rc.ctx.Done()
+		return 0, rc.ctx.Err()
+	}
+
+	if len(p) > 8 {
+		p = p[:8]
+	}
+
+	return rc.ReadCloser.Read(p)
+}
+
+func TestReaderContextBlocked(t *testing.T) {
+	fileData, _ := writeOffsetIndexFile(t)
+	getReaderFunc := bytesGetReaderFunc(fileData)
+
+	testCases := []struct {
+		blockRequest bool  // Blocks column chunk request instead of reading it.
+		blockedRow   int32 // Row whose reading is blocked.
+	}{
+		{true, 0},
+		{false, 0},
+		{false, 3},
+	}
+
+	for i, testCase := range testCases {
+		var blocking bool
+		getReaderFuncContext := func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+			if blocking && testCase.blockRequest && offset >= 0 {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+
+			rc, err := getReaderFunc(offset, length)
+			if err != nil {
+				return nil, err
+			}
+			return &blockingReadCloser{ReadCloser: rc, ctx: ctx, blocking: &blocking}, nil
+		}
+
+		reader, err := NewReaderContext(context.Background(), getReaderFuncContext, nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		for row := int32(0); row < 10; row++ {
+			if row == testCase.blockedRow {
+				blocking = true
+				ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+				start := time.Now()
+				_, err = reader.ReadContext(ctx)
+				cancel()
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Fatalf("case %v: err: expected: %v, got: %v", i+1, context.DeadlineExceeded, err)
+				}
+				if elapsed := time.Since(start); elapsed > time.Second {
+					t.Fatalf("case %v: read is not cancelled in %v", i+1, elapsed)
+				}
+				blocking = false
+			}
+
+			// Blocked row is read again by next call.
+			record, err := reader.ReadContext(context.Background())
+			if err != nil {
+				t.Fatalf("case %v: row %v: %v", i+1, row, err)
+			}
+			if a, _ := record.Get("a"); a.Value != row {
+				t.Fatalf("case %v: row %v: expected: %v, got: %v", i+1, row, row, a.Value)
+			}
+		}
+
+		reader.Close()
+	}
+}
+
+// requestReadCloser - fails reads once context of its request is done like body of HTTP response. Data is
+// read in small parts, hence pages are read by the calls using them.
+type requestReadCloser struct {
+	io.ReadCloser
+	ctx context.Context
+}
+
+func (rc *requestReadCloser) Read(p []byte) (n int, err error) {
+	if err = rc.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(p) > 8 {
+		p = p[:8]
+	}
+
+	return rc.ReadCloser.Read(p)
+}
+
+func TestReaderContextPerCall(t *testing.T) {
+	fileData, _ := writeOffsetIndexFile(t)
+	getReaderFunc := bytesGetReaderFunc(fileData)
+
+	getReaderFuncContext := func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+		rc, err := getReaderFunc(offset, length)
+		if err != nil {
+			return nil, err
+		}
+		return &requestReadCloser{ReadCloser: rc, ctx: ctx}, nil
+	}
+
+	reader, err := NewReaderContext(context.Background(), getReaderFuncContext, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	// Context of each call is cancelled once the call returns; pages of the column chunk are read by later calls.
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		record, err := reader.ReadContext(ctx)
+		cancel()
+		if err != nil {
+			t.Fatalf("row %v: %v", i, err)
+		}
+
+		if a, _ := record.Get("a"); a.Value != int32(i) {
+			t.Fatalf("row %v: expected: %v, got: %v", i, i, a.Value)
+		}
+	}
+
+	if _, err = reader.ReadContext(context.Background()); err != io.EOF {
+		t.Fatalf("expected: %v, got: %v", io.EOF, err)
+	}
+}
+
+// closeCountingWriteCloser - counts Close calls.
+type closeCountingWriteCloser struct {
+	bufferWriteCloser
+	closes int
+}
+
+func (w *closeCountingWriteCloser) Close() error {
+	w.closes++
+	return nil
+}
+
+func int32SchemaTree(t *testing.T) *schema.Tree {
+	a, err := schema.NewElement("a", parquet.FieldRepetitionType_REQUIRED,
+		parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaTree := schema.NewTree()
+	if err = schemaTree.Set("a", a); err != nil {
+		t.Fatal(err)
+	}
+
+	return schemaTree
+}
+
+func TestWriterCloseContext(t *testing.T) {
+	buf := new(closeCountingWriteCloser)
+	writer, err := NewWriter(buf, int32SchemaTree(t), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.WriteJSON([]byte(`{"a": 1}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	size := buf.Len()
+	if err = writer.CloseContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err: expected: %v, got: %v", context.Canceled, err)
+	}
+
+	if buf.Len() != size {
+		t.Fatalf("written size: expected: %v, got: %v", size, buf.Len())
+	}
+
+	// Underneath writer is closed once even on error.
+	if buf.closes != 1 {
+		t.Fatalf("closes: expected: 1, got: %v", buf.closes)
+	}
+
+	if err = writer.Close(); err == nil {
+		t.Fatalf("err: expected: <error>, got: <nil>")
+	}
+	if buf.closes != 1 {
+		t.Fatalf("closes: expected: 1, got: %v", buf.closes)
+	}
+}
+
+func TestWriterWriteContext(t *testing.T) {
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, int32SchemaTree(t), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.WriteJSON([]byte(`{"a": 1}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Record of cancelled write is not written.
+	size := buf.Len()
+	if err = writer.WriteJSONContext(ctx, []byte(`{"a": 2}`)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err: expected: %v, got: %v", context.Canceled, err)
+	}
+	if buf.Len() != size {
+		t.Fatalf("written size: expected: %v, got: %v", size, buf.Len())
+	}
+
+	if err = writer.WriteJSONContext(context.Background(), []byte(`{"a": 3}`)); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() == size {
+		t.Fatalf("written size: expected: > %v, got: %v", size, buf.Len())
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewBytesReader(buf.Bytes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if result := readColumnA(t, reader); result != "[1 3]" {
+		t.Fatalf("expected: [1 3], got: %v", result)
+	}
+}
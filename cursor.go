@@ -0,0 +1,154 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// Cursor - denotes position of a reader returned by Position. It is serializable e.g. by encoding/json
+// to resume reading the file by another reader.
+type Cursor struct {
+	FileID         string   // Identity of the file derived from its footer.
+	RowGroup       int      // Index of row group of next row.
+	Row            int64    // Index of next row in the row group.
+	Columns        []string // Selected column names; empty for all columns.
+	VirtualColumns []string // Selected virtual column names; see SelectVirtualColumns.
+}
+
+// errCursorTargetSchema denotes target schema set by SetTargetSchema, which is not saved in cursor.
+var errCursorTargetSchema = errors.New("parquet: cursor of reader with target schema is not supported")
+
+// fileID returns identity of the file derived from schema, row groups and column chunk offsets in its
+// footer. Column metadata is not used as it depends on decryption keys of the reader.
+func (reader *Reader) fileID() (string, error) {
+	if reader.fileIdentity != "" {
+		return reader.fileIdentity, nil
+	}
+
+	fileMeta := &parquet.FileMetaData{
+		Version:          reader.fileMeta.GetVersion(),
+		Schema:           reader.fileMeta.GetSchema(),
+		NumRows:          reader.fileMeta.GetNumRows(),
+		KeyValueMetadata: reader.fileMeta.GetKeyValueMetadata(),
+		CreatedBy:        reader.fileMeta.CreatedBy,
+	}
+	for _, rowGroup := range reader.rowGroups {
+		identity := &parquet.RowGroup{
+			NumRows:       rowGroup.GetNumRows(),
+			TotalByteSize: rowGroup.GetTotalByteSize(),
+		}
+		for _, columnChunk := range rowGroup.GetColumns() {
+			identity.Columns = append(identity.Columns, &parquet.ColumnChunk{
+				FilePath:   columnChunk.FilePath,
+				FileOffset: columnChunk.GetFileOffset(),
+			})
+		}
+		fileMeta.RowGroups = append(fileMeta.RowGroups, identity)
+	}
+
+	data, err := serializeThrift(fileMeta)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	reader.fileIdentity = hex.EncodeToString(sum[:16])
+	return reader.fileIdentity, nil
+}
+
+// Position - returns cursor of next row to be read by Read. It fails if target schema is set by SetTargetSchema.
+func (reader *Reader) Position() (Cursor, error) {
+	if reader.projection != nil {
+		return Cursor{}, errCursorTargetSchema
+	}
+
+	fileID, err := reader.fileID()
+	if err != nil {
+		return Cursor{}, err
+	}
+
+	row := reader.seekRow
+	if reader.columns != nil {
+		row = reader.rowIndex
+	}
+
+	var columns []string
+	if reader.columnNames != nil {
+		columns = reader.columnNames.ToSlice()
+	}
+
+	return Cursor{
+		FileID:         fileID,
+		RowGroup:       reader.rowGroupIndex,
+		Row:            row,
+		Columns:        columns,
+		VirtualColumns: append([]string(nil), reader.virtualColumns...),
+	}, nil
+}
+
+// Resume - selects columns and virtual columns of cursor and sets next row read by Read to row of cursor as
+// SeekToRow does, hence only pages containing the row and rows after it are read. It fails with
+// ErrCursorMismatch if the cursor is of another file, and fails if target schema is set by SetTargetSchema.
+func (reader *Reader) Resume(cursor Cursor) error {
+	if reader.projection != nil {
+		return errCursorTargetSchema
+	}
+
+	fileID, err := reader.fileID()
+	if err != nil {
+		return err
+	}
+
+	if cursor.FileID != fileID {
+		return fmt.Errorf("%w: file ID %v, cursor file ID %v", ErrCursorMismatch, fileID, cursor.FileID)
+	}
+
+	if cursor.RowGroup < 0 || cursor.RowGroup > len(reader.rowGroups) {
+		return fmt.Errorf("cursor row group %v out of range [0, %v]", cursor.RowGroup, len(reader.rowGroups))
+	}
+
+	var numRows int64
+	if cursor.RowGroup < len(reader.rowGroups) {
+		numRows = reader.rowGroups[cursor.RowGroup].GetNumRows()
+	}
+
+	if cursor.Row < 0 || cursor.Row > numRows {
+		return fmt.Errorf("cursor row %v out of range [0, %v]", cursor.Row, numRows)
+	}
+
+	if err = reader.SelectVirtualColumns(cursor.VirtualColumns...); err != nil {
+		return err
+	}
+
+	if err = reader.SeekToRow(reader.firstRow(cursor.RowGroup) + cursor.Row); err != nil {
+		return err
+	}
+
+	reader.columnNames = nil
+	if len(cursor.Columns) > 0 {
+		reader.columnNames = set.CreateStringSet(cursor.Columns...)
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/minio/parquet-go/schema"
+)
+
+func TestReaderPositionResume(t *testing.T) {
+	fileData := writeSeekFile(t)
+
+	testCases := []struct {
+		columns        [][]string
+		readRows       int
+		expectedCursor Cursor
+	}{
+		{nil, 0, Cursor{RowGroup: 0, Row: 0}},
+		{nil, 3, Cursor{RowGroup: 0, Row: 3}},
+		{[][]string{{"id"}}, 4, Cursor{RowGroup: 0, Row: 4, Columns: []string{"id"}}},
+		{[][]string{{"id"}}, 5, Cursor{RowGroup: 1, Row: 1, Columns: []string{"id"}}},
+		{[][]string{{"a", "array"}, {"id"}}, 9, Cursor{RowGroup: 2, Row: 1, Columns: []string{"a.array", "id"}}},
+		{nil, 10, Cursor{RowGroup: 2, Row: 2}},
+	}
+
+	for i, testCase := range testCases {
+		reader, err := NewBytesReader(fileData, nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		if testCase.columns != nil {
+			reader.SelectColumns(testCase.columns...)
+		}
+
+		for j := 0; j < testCase.readRows; j++ {
+			if _, err = reader.Read(); err != nil {
+				t.Fatalf("case %v: %v", i+1, err)
+			}
+		}
+
+		cursor, err := reader.Position()
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		reader.Close()
+
+		testCase.expectedCursor.FileID = cursor.FileID
+		if !reflect.DeepEqual(cursor, testCase.expectedCursor) {
+			t.Fatalf("case %v: cursor: expected: %+v, got: %+v", i+1, testCase.expectedCursor, cursor)
+		}
+
+		data, err := json.Marshal(cursor)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		var decoded Cursor
+		if err = json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		reader, err = NewBytesReader(fileData, nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if err = reader.Resume(decoded); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		for row := testCase.readRows; row < 10; row++ {
+			record, err := reader.Read()
+			if err != nil {
+				t.Fatalf("case %v: row %v: %v", i+1, row, err)
+			}
+
+			if id, _ := record.Get("id"); id.Value != int32(row) {
+				t.Fatalf("case %v: row %v: id: expected: %v, got: %v", i+1, row, row, id.Value)
+			}
+
+			if _, found := record.Get("a"); found != (testCase.columns == nil || len(testCase.columns) == 2) {
+				t.Fatalf("case %v: row %v: column a: unexpected found: %v", i+1, row, found)
+			}
+		}
+
+		if _, err = reader.Read(); err != io.EOF {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, io.EOF, err)
+		}
+		reader.Close()
+	}
+}
+
+func TestReaderResumeErrors(t *testing.T) {
+	reader, err := NewBytesReader(writeSeekFile(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	cursor, err := reader.Position()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherData, _ := writeOffsetIndexFile(t)
+	otherReader, err := NewBytesReader(otherData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer otherReader.Close()
+
+	if err = otherReader.Resume(cursor); !errors.Is(err, ErrCursorMismatch) {
+		t.Fatalf("err: expected: %v, got: %v", ErrCursorMismatch, err)
+	}
+
+	testCases := []Cursor{
+		{FileID: cursor.FileID, RowGroup: -1},
+		{FileID: cursor.FileID, RowGroup: 4},
+		{FileID: cursor.FileID, RowGroup: 0, Row: 5},
+		{FileID: cursor.FileID, RowGroup: 3, Row: 1},
+	}
+
+	for i, testCase := range testCases {
+		if err = reader.Resume(testCase); err == nil {
+			t.Fatalf("case %v: expected: <error>, got: <nil>", i+1)
+		}
+	}
+}
+
+func TestReaderPositionVirtualColumns(t *testing.T) {
+	fileData := writeSeekFile(t)
+
+	reader, err := NewBytesReader(fileData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = reader.SelectVirtualColumns(RowIndexColumn); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err = reader.Read(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cursor, err := reader.Position()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader.Close()
+
+	if !reflect.DeepEqual(cursor.VirtualColumns, []string{RowIndexColumn}) {
+		t.Fatalf("virtual columns: expected: %v, got: %v", []string{RowIndexColumn}, cursor.VirtualColumns)
+	}
+
+	reader, err = NewBytesReader(fileData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if err = reader.Resume(cursor); err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := reader.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rowIndex, _ := record.Get(RowIndexColumn); rowIndex.Value != int64(3) {
+		t.Fatalf("row index: expected: 3, got: %v", rowIndex.Value)
+	}
+}
+
+func TestReaderPositionTargetSchema(t *testing.T) {
+	fileData := writeSeekFile(t)
+
+	reader, err := NewBytesReader(fileData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	cursor, err := reader.Position()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tree, err := schema.Parse("message m { required int32 id; }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = reader.SetTargetSchema(tree, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Target schema is not saved in cursor.
+	if _, err = reader.Position(); err == nil {
+		t.Fatalf("position: expected: <error>, got: <nil>")
+	}
+
+	if err = reader.Resume(cursor); err == nil {
+		t.Fatalf("resume: expected: <error>, got: <nil>")
+	}
+}
+
+func TestReaderResumeOffsetIndex(t *testing.T) {
+	fileData, locations := writeOffsetIndexFile(t)
+
+	reader, err := NewBytesReader(fileData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 7; i++ {
+		if _, err = reader.Read(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cursor, err := reader.Position()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader.Close()
+
+	getReaderFunc := bytesGetReaderFunc(fileData)
+	var offsets []int64
+	reader, err = NewReader(func(offset, length int64) (io.ReadCloser, error) {
+		offsets = append(offsets, offset)
+		return getReaderFunc(offset, length)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if err = reader.Resume(cursor); err != nil {
+		t.Fatal(err)
+	}
+
+	offsets = nil
+	record, err := reader.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a, _ := record.Get("a"); a.Value != int32(7) {
+		t.Fatalf("expected: 7, got: %v", a.Value)
+	}
+
+	// Offset index is read, then column chunk is read from third page.
+	if expected := locations[2].Offset; offsets[len(offsets)-1] != expected {
+		t.Fatalf("read offset: expected: %v, got: %v", expected, offsets[len(offsets)-1])
+	}
+}
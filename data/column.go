@@ -23,8 +23,10 @@ import (
 	"strings"
 
 	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/minio/parquet-go/bloom"
 	"github.com/minio/parquet-go/common"
 	"github.com/minio/parquet-go/encoding"
+	"github.com/minio/parquet-go/encryption"
 	"github.com/minio/parquet-go/gen-go/parquet"
 	"github.com/minio/parquet-go/schema"
 	"github.com/tidwall/gjson"
@@ -44,6 +46,57 @@ func getDefaultEncoding(parquetType parquet.Type) parquet.Encoding {
 	return parquet.Encoding_PLAIN
 }
 
+func getCompression(element *schema.Element) (compressionType parquet.CompressionCodec, compressionLevel int) {
+	compressionType = parquet.CompressionCodec_SNAPPY
+	if element.CompressionType != nil {
+		compressionType = *element.CompressionType
+	}
+
+	compressionLevel = common.DefaultCompressionLevel
+	if element.CompressionLevel != nil {
+		compressionLevel = *element.CompressionLevel
+	}
+
+	return compressionType, compressionLevel
+}
+
+// encodePage returns serialized page header followed by page data. Both are encrypted if opts.Cipher is set.
+func encodePage(pageHeader *parquet.PageHeader, pageData []byte, opts EncodeOptions) []byte {
+	var err error
+	if opts.Cipher != nil {
+		moduleType := encryption.DataPage
+		if pageHeader.Type == parquet.PageType_DICTIONARY_PAGE {
+			moduleType = encryption.DictionaryPage
+		}
+
+		// Every column chunk has only one data page, hence page ordinal is always zero.
+		if pageData, err = opts.Cipher.Encrypt(moduleType, opts.RowGroupOrdinal, opts.ColumnOrdinal, 0, pageData); err != nil {
+			panic(err)
+		}
+		pageHeader.CompressedPageSize = int32(len(pageData))
+	}
+
+	ts := thrift.NewTSerializer()
+	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+	rawData, err := ts.Write(context.TODO(), pageHeader)
+	if err != nil {
+		panic(err)
+	}
+
+	if opts.Cipher != nil {
+		moduleType := encryption.DataPageHeader
+		if pageHeader.Type == parquet.PageType_DICTIONARY_PAGE {
+			moduleType = encryption.DictionaryPageHeader
+		}
+
+		if rawData, err = opts.Cipher.Encrypt(moduleType, opts.RowGroupOrdinal, opts.ColumnOrdinal, 0, rawData); err != nil {
+			panic(err)
+		}
+	}
+
+	return append(rawData, pageData...)
+}
+
 func getFirstValueElement(tree *schema.Tree) (valueElement *schema.Element) {
 	tree.Range(func(name string, element *schema.Element) bool {
 		if element.Children == nil {
@@ -61,9 +114,7 @@ func getFirstValueElement(tree *schema.Tree) (valueElement *schema.Element) {
 func populate(columnDataMap map[string]*Column, input *jsonValue, tree *schema.Tree, firstValueRL int64) (map[string]*Column, error) {
 	var err error
 
-	pos := 0
 	handleElement := func(name string, element *schema.Element) bool {
-		pos++
 
 		dataPath := element.PathInTree
 
@@ -98,12 +149,7 @@ func populate(columnDataMap map[string]*Column, input *jsonValue, tree *schema.T
 				DL--
 			}
 
-			RL := element.MaxRepetitionLevel
-			if pos == 1 {
-				RL = firstValueRL
-			}
-
-			add(element, value, DL, RL)
+			add(element, value, DL, firstValueRL)
 			return true
 		}
 
@@ -115,12 +161,7 @@ func populate(columnDataMap map[string]*Column, input *jsonValue, tree *schema.T
 				DL--
 			}
 
-			RL := element.MaxRepetitionLevel
-			if RL > 0 {
-				RL--
-			}
-
-			add(valueElement, nil, DL, RL)
+			add(valueElement, nil, DL, firstValueRL)
 		}
 
 		// Handle group type element.
@@ -147,7 +188,11 @@ func populate(columnDataMap map[string]*Column, input *jsonValue, tree *schema.T
 			}
 
 			listElement, _ := element.Children.Get("list")
-			valueElement, _ := listElement.Children.Get("element")
+			valueElement, _ := element.Children.Get("list.element")
+			if valueElement == nil {
+				err = fmt.Errorf("%v: legacy LIST structure is not supported for writing", dataPath)
+				return false
+			}
 			for i := range results {
 				rl := valueElement.MaxRepetitionLevel
 				if i == 0 {
@@ -177,8 +222,14 @@ func populate(columnDataMap map[string]*Column, input *jsonValue, tree *schema.T
 				return true
 			}
 
-			keyValueElement, _ := element.Children.Get("key_value")
+			keyValueElement, ok := element.Children.Get("key_value")
+			if !ok {
+				err = fmt.Errorf("%v: legacy MAP structure is not supported for writing", dataPath)
+				return false
+			}
+
 			var rerr error
+			rl := firstValueRL
 			err = inputValue.Range(func(key, value gjson.Result) bool {
 				if !key.Exists() || key.Type == gjson.Null {
 					rerr = fmt.Errorf("%v.key_value.key: not found or null", dataPath)
@@ -199,10 +250,11 @@ func populate(columnDataMap map[string]*Column, input *jsonValue, tree *schema.T
 					return false
 				}
 
-				if columnDataMap, rerr = populate(columnDataMap, jv, keyValueElement.Children, firstValueRL); rerr != nil {
+				if columnDataMap, rerr = populate(columnDataMap, jv, keyValueElement.Children, rl); rerr != nil {
 					return false
 				}
 
+				rl = keyValueElement.MaxRepetitionLevel
 				return true
 			})
 
@@ -443,7 +495,7 @@ func (column *Column) encodeValue(value interface{}, element *schema.Element) []
 	return valueData
 }
 
-func (column *Column) toDataPageV2(element *schema.Element, parquetEncoding parquet.Encoding) *ColumnChunk {
+func (column *Column) toDataPageV2(element *schema.Element, parquetEncoding parquet.Encoding, opts EncodeOptions) *ColumnChunk {
 	var definedValues []interface{}
 	for _, value := range column.values {
 		if value != nil {
@@ -462,29 +514,34 @@ func (column *Column) toDataPageV2(element *schema.Element, parquetEncoding parq
 			bytesSlices = append(bytesSlices, value.([]byte))
 		}
 		encodedData = encoding.DeltaLengthByteArrayEncode(bytesSlices)
-	}
 
-	compressionType := parquet.CompressionCodec_SNAPPY
-	if element.CompressionType != nil {
-		compressionType = *element.CompressionType
+	case parquet.Encoding_BYTE_STREAM_SPLIT:
+		encodedData = encoding.ByteStreamSplitEncode(common.ToSliceValue(definedValues, column.parquetType), column.parquetType)
 	}
 
-	compressedData, err := common.Compress(compressionType, encodedData)
+	compressionType, compressionLevel := getCompression(element)
+	compressedData, err := common.CompressLevel(compressionType, encodedData, compressionLevel)
 	if err != nil {
 		panic(err)
 	}
 
-	DLData := encoding.RLEBitPackedHybridEncode(
-		column.definitionLevels,
-		common.BitWidth(uint64(element.MaxDefinitionLevel)),
-		parquet.Type_INT64,
-	)
+	// Levels of data page v2 are not prefixed by their length and are omitted if max level is zero.
+	var DLData, RLData []byte
+	if element.MaxDefinitionLevel > 0 {
+		DLData = encoding.RLEBitPackedHybridEncode(
+			column.definitionLevels,
+			common.BitWidth(uint64(element.MaxDefinitionLevel)),
+			parquet.Type_INT64,
+		)[4:]
+	}
 
-	RLData := encoding.RLEBitPackedHybridEncode(
-		column.repetitionLevels,
-		common.BitWidth(uint64(element.MaxRepetitionLevel)),
-		parquet.Type_INT64,
-	)
+	if element.MaxRepetitionLevel > 0 {
+		RLData = encoding.RLEBitPackedHybridEncode(
+			column.repetitionLevels,
+			common.BitWidth(uint64(element.MaxRepetitionLevel)),
+			parquet.Type_INT64,
+		)[4:]
+	}
 
 	pageHeader := parquet.NewPageHeader()
 	pageHeader.Type = parquet.PageType_DATA_PAGE_V2
@@ -501,16 +558,12 @@ func (column *Column) toDataPageV2(element *schema.Element, parquetEncoding parq
 	pageHeader.DataPageHeaderV2.Statistics = parquet.NewStatistics()
 	pageHeader.DataPageHeaderV2.Statistics.Min = column.encodeValue(column.minValue, element)
 	pageHeader.DataPageHeaderV2.Statistics.Max = column.encodeValue(column.maxValue, element)
-
-	ts := thrift.NewTSerializer()
-	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
-	rawData, err := ts.Write(context.TODO(), pageHeader)
-	if err != nil {
-		panic(err)
+	if opts.PageChecksum {
+		pageHeader.Crc = common.PageChecksum(RLData, DLData, compressedData)
 	}
-	rawData = append(rawData, RLData...)
-	rawData = append(rawData, DLData...)
-	rawData = append(rawData, compressedData...)
+
+	pageData := append(append(RLData, DLData...), compressedData...)
+	rawData := encodePage(pageHeader, pageData, opts)
 
 	metadata := parquet.NewColumnMetaData()
 	metadata.Type = column.parquetType
@@ -519,11 +572,14 @@ func (column *Column) toDataPageV2(element *schema.Element, parquetEncoding parq
 		parquet.Encoding_RLE,
 		parquet.Encoding_DELTA_LENGTH_BYTE_ARRAY,
 	}
+	if parquetEncoding == parquet.Encoding_BYTE_STREAM_SPLIT {
+		metadata.Encodings = append(metadata.Encodings, parquetEncoding)
+	}
 	metadata.Codec = compressionType
 	metadata.NumValues = int64(pageHeader.DataPageHeaderV2.NumValues)
 	metadata.TotalCompressedSize = int64(len(rawData))
 	metadata.TotalUncompressedSize = int64(pageHeader.UncompressedPageSize) + int64(len(rawData)) - int64(pageHeader.CompressedPageSize)
-	metadata.PathInSchema = strings.Split(element.PathInSchema, ".")
+	metadata.PathInSchema = schema.SplitPath(element.PathInSchema)
 	metadata.Statistics = parquet.NewStatistics()
 	metadata.Statistics.Min = pageHeader.DataPageHeaderV2.Statistics.Min
 	metadata.Statistics.Max = pageHeader.DataPageHeaderV2.Statistics.Max
@@ -537,15 +593,11 @@ func (column *Column) toDataPageV2(element *schema.Element, parquetEncoding parq
 	return chunk
 }
 
-func (column *Column) toRLEDictPage(element *schema.Element) *ColumnChunk {
+func (column *Column) toRLEDictPage(element *schema.Element, opts EncodeOptions) *ColumnChunk {
 	dictPageData, dataPageData, dictValueCount, indexBitWidth := encoding.RLEDictEncode(column.values, column.parquetType, column.maxBitWidth)
 
-	compressionType := parquet.CompressionCodec_SNAPPY
-	if element.CompressionType != nil {
-		compressionType = *element.CompressionType
-	}
-
-	compressedData, err := common.Compress(compressionType, dictPageData)
+	compressionType, compressionLevel := getCompression(element)
+	compressedData, err := common.CompressLevel(compressionType, dictPageData, compressionLevel)
 	if err != nil {
 		panic(err)
 	}
@@ -557,33 +609,34 @@ func (column *Column) toRLEDictPage(element *schema.Element) *ColumnChunk {
 	dictPageHeader.DictionaryPageHeader = parquet.NewDictionaryPageHeader()
 	dictPageHeader.DictionaryPageHeader.NumValues = dictValueCount
 	dictPageHeader.DictionaryPageHeader.Encoding = parquet.Encoding_PLAIN
-
-	ts := thrift.NewTSerializer()
-	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
-	dictPageRawData, err := ts.Write(context.TODO(), dictPageHeader)
-	if err != nil {
-		panic(err)
+	if opts.PageChecksum {
+		dictPageHeader.Crc = common.PageChecksum(compressedData)
 	}
-	dictPageRawData = append(dictPageRawData, compressedData...)
 
-	RLData := encoding.RLEBitPackedHybridEncode(
-		column.repetitionLevels,
-		common.BitWidth(uint64(element.MaxRepetitionLevel)),
-		parquet.Type_INT64,
-	)
-	encodedData := RLData
+	dictPageRawData := encodePage(dictPageHeader, compressedData, opts)
+
+	// Levels are omitted if max level is zero.
+	var encodedData []byte
+	if element.MaxRepetitionLevel > 0 {
+		encodedData = append(encodedData, encoding.RLEBitPackedHybridEncode(
+			column.repetitionLevels,
+			common.BitWidth(uint64(element.MaxRepetitionLevel)),
+			parquet.Type_INT64,
+		)...)
+	}
 
-	DLData := encoding.RLEBitPackedHybridEncode(
-		column.definitionLevels,
-		common.BitWidth(uint64(element.MaxDefinitionLevel)),
-		parquet.Type_INT64,
-	)
-	encodedData = append(encodedData, DLData...)
+	if element.MaxDefinitionLevel > 0 {
+		encodedData = append(encodedData, encoding.RLEBitPackedHybridEncode(
+			column.definitionLevels,
+			common.BitWidth(uint64(element.MaxDefinitionLevel)),
+			parquet.Type_INT64,
+		)...)
+	}
 
 	encodedData = append(encodedData, indexBitWidth)
 	encodedData = append(encodedData, dataPageData...)
 
-	compressedData, err = common.Compress(compressionType, encodedData)
+	compressedData, err = common.CompressLevel(compressionType, encodedData, compressionLevel)
 	if err != nil {
 		panic(err)
 	}
@@ -597,14 +650,11 @@ func (column *Column) toRLEDictPage(element *schema.Element) *ColumnChunk {
 	dataPageHeader.DataPageHeader.DefinitionLevelEncoding = parquet.Encoding_RLE
 	dataPageHeader.DataPageHeader.RepetitionLevelEncoding = parquet.Encoding_RLE
 	dataPageHeader.DataPageHeader.Encoding = parquet.Encoding_RLE_DICTIONARY
-
-	ts = thrift.NewTSerializer()
-	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
-	dataPageRawData, err := ts.Write(context.TODO(), dataPageHeader)
-	if err != nil {
-		panic(err)
+	if opts.PageChecksum {
+		dataPageHeader.Crc = common.PageChecksum(compressedData)
 	}
-	dataPageRawData = append(dataPageRawData, compressedData...)
+
+	dataPageRawData := encodePage(dataPageHeader, compressedData, opts)
 
 	metadata := parquet.NewColumnMetaData()
 	metadata.Type = column.parquetType
@@ -620,7 +670,7 @@ func (column *Column) toRLEDictPage(element *schema.Element) *ColumnChunk {
 	uncompressedSize := int64(dictPageHeader.UncompressedPageSize) + int64(len(dictPageData)) - int64(dictPageHeader.CompressedPageSize)
 	uncompressedSize += int64(dataPageHeader.UncompressedPageSize) + int64(len(dataPageData)) - int64(dataPageHeader.CompressedPageSize)
 	metadata.TotalUncompressedSize = uncompressedSize
-	metadata.PathInSchema = strings.Split(element.PathInSchema, ".")
+	metadata.PathInSchema = schema.SplitPath(element.PathInSchema)
 	metadata.Statistics = parquet.NewStatistics()
 	metadata.Statistics.Min = column.encodeValue(column.minValue, element)
 	metadata.Statistics.Max = column.encodeValue(column.maxValue, element)
@@ -636,19 +686,100 @@ func (column *Column) toRLEDictPage(element *schema.Element) *ColumnChunk {
 	return chunk
 }
 
-// Encode an element.
+// EncodeOptions - options to encode column chunk.
+type EncodeOptions struct {
+	PageChecksum    bool               // Sets CRC32 checksum of compressed page data in page header.
+	Cipher          *encryption.Cipher // Encrypts pages and page headers if set.
+	RowGroupOrdinal int16              // Row group ordinal used in AAD of encrypted modules.
+	ColumnOrdinal   int16              // Column ordinal used in AAD of encrypted modules.
+}
+
+// Encode an element. It panics if values do not conform to element; see EncodeWithOptions.
 func (column *Column) Encode(element *schema.Element) *ColumnChunk {
+	chunk, err := column.EncodeWithOptions(element, EncodeOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	return chunk
+}
+
+// checkValues returns error if a FIXED_LEN_BYTE_ARRAY value is not of TypeLength bytes of element.
+func (column *Column) checkValues(element *schema.Element) error {
+	if column.parquetType != parquet.Type_FIXED_LEN_BYTE_ARRAY {
+		return nil
+	}
+
+	for _, value := range column.values {
+		if value == nil {
+			continue
+		}
+
+		if bytesValue, ok := value.([]byte); !ok || len(bytesValue) != int(element.GetTypeLength()) {
+			return fmt.Errorf("%v: value %v is not of type length %v", element.PathInSchema, value, element.GetTypeLength())
+		}
+	}
+
+	return nil
+}
+
+// EncodeWithOptions encodes an element using opts. It fails if values do not conform to element, e.g. a
+// FIXED_LEN_BYTE_ARRAY value is not of TypeLength bytes.
+func (column *Column) EncodeWithOptions(element *schema.Element, opts EncodeOptions) (*ColumnChunk, error) {
+	if err := column.checkValues(element); err != nil {
+		return nil, err
+	}
+
 	parquetEncoding := getDefaultEncoding(column.parquetType)
 	if element.Encoding != nil {
 		parquetEncoding = *element.Encoding
 	}
 
+	var chunk *ColumnChunk
 	switch parquetEncoding {
-	case parquet.Encoding_PLAIN, parquet.Encoding_DELTA_LENGTH_BYTE_ARRAY:
-		return column.toDataPageV2(element, parquetEncoding)
+	case parquet.Encoding_PLAIN, parquet.Encoding_DELTA_LENGTH_BYTE_ARRAY, parquet.Encoding_BYTE_STREAM_SPLIT:
+		chunk = column.toDataPageV2(element, parquetEncoding, opts)
+	default:
+		chunk = column.toRLEDictPage(element, opts)
+	}
+
+	if element.BloomFilter {
+		chunk.bloomFilter = column.bloomFilter(element)
+	}
+
+	return chunk, nil
+}
+
+// bloomFilter returns Bloom filter of non-null values. BOOLEAN column has no Bloom filter.
+func (column *Column) bloomFilter(element *schema.Element) *bloom.Filter {
+	if column.parquetType == parquet.Type_BOOLEAN {
+		return nil
+	}
+
+	hashes := make(map[uint64]struct{})
+	for _, value := range column.values {
+		if value == nil {
+			continue
+		}
+
+		hash, err := bloom.Hash(value)
+		if err != nil {
+			panic(err)
+		}
+		hashes[hash] = struct{}{}
+	}
+
+	fpp := bloom.DefaultFPP
+	if element.BloomFilterFPP != nil {
+		fpp = *element.BloomFilterFPP
+	}
+
+	filter := bloom.New(bloom.OptimalNumBytes(uint64(len(hashes)), fpp))
+	for hash := range hashes {
+		filter.Insert(hash)
 	}
 
-	return column.toRLEDictPage(element)
+	return filter
 }
 
 // NewColumn - creates new column data
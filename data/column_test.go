@@ -17,9 +17,11 @@
 package data
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/minio/parquet-go/gen-go/parquet"
 	"github.com/minio/parquet-go/schema"
 )
@@ -367,3 +369,112 @@ func TestAddressBookExample(t *testing.T) {
 		}
 	}
 }
+
+func TestDataPageV2Layout(t *testing.T) {
+	// Levels of data page v2 are stored uncompressed without 4-byte length prefix as parquet-format specifies;
+	// their lengths are DefinitionLevelsByteLength and RepetitionLevelsByteLength in page header.
+	testCases := []struct {
+		repetitionType   parquet.FieldRepetitionType
+		values           []interface{}
+		expectedDLLen    int32
+		expectedPageData []byte
+	}{
+		{
+			parquet.FieldRepetitionType_REQUIRED,
+			[]interface{}{int32(1), int32(3)},
+			0,
+			[]byte{1, 0, 0, 0, 3, 0, 0, 0},
+		},
+		{
+			parquet.FieldRepetitionType_OPTIONAL,
+			[]interface{}{int32(1), nil, int32(3)},
+			6,
+			[]byte{
+				2, 1, 2, 0, 2, 1, // definition levels 1, 0, 1 as RLE runs
+				1, 0, 0, 0, 3, 0, 0, 0,
+			},
+		},
+	}
+
+	for i, testCase := range testCases {
+		element, err := schema.NewElement("a", testCase.repetitionType,
+			parquet.TypePtr(parquet.Type_INT32), nil,
+			parquet.EncodingPtr(parquet.Encoding_PLAIN), parquet.CompressionCodecPtr(parquet.CompressionCodec_UNCOMPRESSED),
+			nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		element.PathInSchema = "a"
+		if testCase.repetitionType == parquet.FieldRepetitionType_OPTIONAL {
+			element.MaxDefinitionLevel = 1
+		}
+
+		column := NewColumn(parquet.Type_INT32)
+		for _, value := range testCase.values {
+			if value == nil {
+				column.AddNull(0, 0)
+			} else {
+				column.AddInt32(value.(int32), element.MaxDefinitionLevel, 0)
+			}
+		}
+
+		buffer := thrift.NewTMemoryBuffer()
+		buffer.Write(column.Encode(element).Data())
+		pageHeader := parquet.NewPageHeader()
+		if err := pageHeader.Read(context.Background(), thrift.NewTCompactProtocol(buffer)); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if pageHeader.Type != parquet.PageType_DATA_PAGE_V2 {
+			t.Fatalf("case %v: page type: expected: %v, got: %v", i+1, parquet.PageType_DATA_PAGE_V2, pageHeader.Type)
+		}
+
+		if dlLen := pageHeader.DataPageHeaderV2.DefinitionLevelsByteLength; dlLen != testCase.expectedDLLen {
+			t.Fatalf("case %v: definition levels length: expected: %v, got: %v", i+1, testCase.expectedDLLen, dlLen)
+		}
+
+		if rlLen := pageHeader.DataPageHeaderV2.RepetitionLevelsByteLength; rlLen != 0 {
+			t.Fatalf("case %v: repetition levels length: expected: 0, got: %v", i+1, rlLen)
+		}
+
+		if pageData := buffer.Bytes(); !reflect.DeepEqual(pageData, testCase.expectedPageData) {
+			t.Fatalf("case %v: page data: expected: %x, got: %x", i+1, testCase.expectedPageData, pageData)
+		}
+	}
+}
+
+func TestColumnEncodeFixedLenByteArray(t *testing.T) {
+	testCases := []struct {
+		values    [][]byte
+		expectErr bool
+	}{
+		{[][]byte{[]byte("ab"), []byte("cd")}, false},
+		{[][]byte{[]byte("ab"), []byte("cde")}, true},
+		{[][]byte{[]byte("a"), []byte("b")}, true},
+	}
+
+	for i, testCase := range testCases {
+		element, err := schema.NewElement("a", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_FIXED_LEN_BYTE_ARRAY), nil,
+			parquet.EncodingPtr(parquet.Encoding_BYTE_STREAM_SPLIT), parquet.CompressionCodecPtr(parquet.CompressionCodec_UNCOMPRESSED),
+			nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		element.PathInSchema = "a"
+		typeLength := int32(2)
+		element.TypeLength = &typeLength
+
+		column := &Column{parquetType: parquet.Type_FIXED_LEN_BYTE_ARRAY}
+		for _, value := range testCase.values {
+			column.values = append(column.values, value)
+			column.definitionLevels = append(column.definitionLevels, 0)
+			column.repetitionLevels = append(column.repetitionLevels, 0)
+		}
+
+		_, err = column.EncodeWithOptions(element, EncodeOptions{})
+		if testCase.expectErr != (err != nil) {
+			t.Fatalf("case %v: expected error: %v, got: %v", i+1, testCase.expectErr, err)
+		}
+	}
+}
@@ -17,6 +17,7 @@
 package data
 
 import (
+	"github.com/minio/parquet-go/bloom"
 	"github.com/minio/parquet-go/gen-go/parquet"
 )
 
@@ -28,6 +29,7 @@ type ColumnChunk struct {
 	dataPageLen int64
 	dataLen     int64
 	data        []byte
+	bloomFilter *bloom.Filter
 }
 
 // Data returns the data.
@@ -40,6 +42,11 @@ func (chunk *ColumnChunk) DataLen() int64 {
 	return chunk.dataLen
 }
 
+// BloomFilter returns the Bloom filter of the chunk if any.
+func (chunk *ColumnChunk) BloomFilter() *bloom.Filter {
+	return chunk.bloomFilter
+}
+
 // NewRowGroup creates a new row group.
 func NewRowGroup(chunks []*ColumnChunk, numRows, offset int64) *parquet.RowGroup {
 	rows := parquet.NewRowGroup()
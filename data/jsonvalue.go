@@ -18,11 +18,29 @@ package data
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/minio/parquet-go/gen-go/parquet"
 	"github.com/tidwall/gjson"
 )
 
+// escapeKey returns key escaped for gjson path syntax e.g. '.', '*' and '?' in key are escaped by '\'.
+func escapeKey(key string) string {
+	var sb strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case c <= ' ' || c > '~' || c == '_' || c == '-' || c == ':':
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9'):
+		default:
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(c)
+	}
+
+	return sb.String()
+}
+
 type jsonValue struct {
 	result *gjson.Result
 	path   *string
@@ -54,7 +72,7 @@ func (v *jsonValue) Get(path string) *jsonValue {
 		return resultToJSONValue(nil)
 	}
 
-	result := v.result.Get(path)
+	result := v.result.Get(escapeKey(path))
 	if !result.Exists() {
 		return resultToJSONValue(nil)
 	}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/minio/parquet-go/gen-go/parquet"
@@ -34,17 +35,29 @@ func i64sToi32s(i64s []int64) (i32s []int32) {
 	return i32s
 }
 
-func readBitPacked(reader *bytes.Reader, header, bitWidth uint64) (result []int64, err error) {
+// readBitPacked reads header groups of 8 values of bitWidth and returns up to maxCount values. Values beyond
+// maxCount are skipped.
+func readBitPacked(reader *bytes.Reader, header, bitWidth, maxCount uint64) (result []int64, err error) {
 	count := header * 8
+	if header > math.MaxUint64/8 || count > maxCount {
+		count = maxCount
+	}
 
-	if count == 0 {
+	if header == 0 || (count == 0 && bitWidth == 0) {
 		return result, nil
 	}
 
 	if bitWidth == 0 {
+		if count > math.MaxInt64/8 {
+			return nil, errors.New("parquet: size too large")
+		}
 		return make([]int64, count), nil
 	}
 
+	if bitWidth > 64 || header > uint64(reader.Len())/bitWidth {
+		return nil, errors.New("parquet: bit packed run exceeds data")
+	}
+
 	data := make([]byte, header*bitWidth)
 	if _, err = reader.Read(data); err != nil {
 		return nil, err
@@ -68,7 +81,9 @@ func readBitPacked(reader *bytes.Reader, header, bitWidth uint64) (result []int6
 
 		if left >= valNeedBits {
 			val |= ((b >> used) & ((1 << valNeedBits) - 1)) << (bitWidth - valNeedBits)
-			result = append(result, int64(val))
+			if uint64(len(result)) < count {
+				result = append(result, int64(val))
+			}
 			val = 0
 			left -= valNeedBits
 			used += valNeedBits
@@ -84,11 +99,15 @@ func readBitPacked(reader *bytes.Reader, header, bitWidth uint64) (result []int6
 }
 
 func readBools(reader *bytes.Reader, count uint64) (result []bool, err error) {
-	i64s, err := readBitPacked(reader, count, 1)
+	i64s, err := readBitPacked(reader, (count+7)/8, 1, count)
 	if err != nil {
 		return nil, err
 	}
 
+	if uint64(len(i64s)) < count {
+		return nil, io.ErrUnexpectedEOF
+	}
+
 	var i uint64
 	for i = 0; i < count; i++ {
 		result = append(result, i64s[i] > 0)
@@ -184,6 +203,9 @@ func readByteArrays(reader *bytes.Reader, count uint64) (result [][]byte, err er
 		}
 
 		length = bytesToUint32(buf)
+		if int64(length) > int64(reader.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
 		data = make([]byte, length)
 		if length > 0 {
 			if _, err = reader.Read(data); err != nil {
@@ -198,6 +220,10 @@ func readByteArrays(reader *bytes.Reader, count uint64) (result [][]byte, err er
 }
 
 func readFixedLenByteArrays(reader *bytes.Reader, count, length uint64) (result [][]byte, err error) {
+	if count > 0 && length > uint64(reader.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
 	var i uint64
 	for i = 0; i < count; i++ {
 		data := make([]byte, length)
@@ -253,7 +279,8 @@ func readUnsignedVarInt(reader *bytes.Reader) (v uint64, err error) {
 	return v, nil
 }
 
-func readRLE(reader *bytes.Reader, header, bitWidth uint64) (result []int64, err error) {
+// readRLE reads run of header and returns up to maxCount values.
+func readRLE(reader *bytes.Reader, header, bitWidth, maxCount uint64) (result []int64, err error) {
 	width := (bitWidth + 7) / 8
 	data := make([]byte, width)
 	if width > 0 {
@@ -268,6 +295,9 @@ func readRLE(reader *bytes.Reader, header, bitWidth uint64) (result []int64, err
 
 	val := int64(bytesToUint32(data))
 	count := header >> 1
+	if count > maxCount {
+		count = maxCount
+	}
 	if count > math.MaxInt64/8 {
 		// 8 bytes/element.
 		return nil, errors.New("parquet: size too large")
@@ -280,7 +310,8 @@ func readRLE(reader *bytes.Reader, header, bitWidth uint64) (result []int64, err
 	return result, nil
 }
 
-func readRLEBitPackedHybrid(reader *bytes.Reader, length, bitWidth uint64) (result []int64, err error) {
+// readRLEBitPackedHybrid reads runs of length bytes till count values are read.
+func readRLEBitPackedHybrid(reader *bytes.Reader, length, bitWidth, count uint64) (result []int64, err error) {
 	if length <= 0 {
 		var i32s []int32
 		i32s, err = readInt32s(reader, 1)
@@ -293,13 +324,17 @@ func readRLEBitPackedHybrid(reader *bytes.Reader, length, bitWidth uint64) (resu
 		length = uint64(i32s[0])
 	}
 
+	if length > uint64(reader.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
 	buf := make([]byte, length)
 	if _, err = reader.Read(buf); err != nil {
 		return nil, err
 	}
 
 	reader = bytes.NewReader(buf)
-	for reader.Len() > 0 {
+	for reader.Len() > 0 && uint64(len(result)) < count {
 		header, err := readUnsignedVarInt(reader)
 		if err != nil {
 			return nil, err
@@ -307,9 +342,9 @@ func readRLEBitPackedHybrid(reader *bytes.Reader, length, bitWidth uint64) (resu
 
 		var i64s []int64
 		if header&1 == 0 {
-			i64s, err = readRLE(reader, header, bitWidth)
+			i64s, err = readRLE(reader, header, bitWidth, count-uint64(len(result)))
 		} else {
-			i64s, err = readBitPacked(reader, header>>1, bitWidth)
+			i64s, err = readBitPacked(reader, header>>1, bitWidth, count-uint64(len(result)))
 		}
 
 		if err != nil {
@@ -322,7 +357,8 @@ func readRLEBitPackedHybrid(reader *bytes.Reader, length, bitWidth uint64) (resu
 	return result, nil
 }
 
-func readDeltaBinaryPackedInt(reader *bytes.Reader) (result []int64, err error) {
+// readDeltaBinaryPackedInt reads delta encoded values. Values more than count are rejected.
+func readDeltaBinaryPackedInt(reader *bytes.Reader, count uint64) (result []int64, err error) {
 	blockSize, err := readUnsignedVarInt(reader)
 	if err != nil {
 		return nil, err
@@ -338,6 +374,10 @@ func readDeltaBinaryPackedInt(reader *bytes.Reader) (result []int64, err error)
 		return nil, err
 	}
 
+	if numValues > count {
+		return nil, fmt.Errorf("parquet: delta value count %v exceeds %v", numValues, count)
+	}
+
 	firstValueZigZag, err := readUnsignedVarInt(reader)
 	if err != nil {
 		return nil, err
@@ -350,7 +390,14 @@ func readDeltaBinaryPackedInt(reader *bytes.Reader) (result []int64, err error)
 	}
 	numValuesInMiniBlock := blockSize / numMiniblocksInBlock
 
-	bitWidths := make([]uint64, numMiniblocksInBlock)
+	// Bit width of each mini block takes a byte, hence mini blocks are limited to data.
+	var bitWidths []uint64
+	if numValues > 1 {
+		if numMiniblocksInBlock > uint64(reader.Len()) {
+			return nil, errors.New("parquet: mini blocks exceed data")
+		}
+		bitWidths = make([]uint64, numMiniblocksInBlock)
+	}
 	for uint64(len(result)) < numValues {
 		minDeltaZigZag, err := readUnsignedVarInt(reader)
 		if err != nil {
@@ -362,12 +409,20 @@ func readDeltaBinaryPackedInt(reader *bytes.Reader) (result []int64, err error)
 			if err != nil {
 				return nil, err
 			}
+			if b > 64 {
+				return nil, fmt.Errorf("parquet: invalid bit width %v", b)
+			}
 			bitWidths[i] = uint64(b)
 		}
 
 		minDelta := int64(minDeltaZigZag>>1) ^ (-int64(minDeltaZigZag & 1))
 		for i := 0; uint64(i) < numMiniblocksInBlock; i++ {
-			i64s, err := readBitPacked(reader, numValuesInMiniBlock/8, bitWidths[i])
+			var maxCount uint64
+			if uint64(len(result)) < numValues {
+				maxCount = numValues - uint64(len(result))
+			}
+
+			i64s, err := readBitPacked(reader, numValuesInMiniBlock/8, bitWidths[i], maxCount)
 			if err != nil {
 				return nil, err
 			}
@@ -382,13 +437,17 @@ func readDeltaBinaryPackedInt(reader *bytes.Reader) (result []int64, err error)
 	return result[:numValues], nil
 }
 
-func readDeltaLengthByteArrays(reader *bytes.Reader) (result [][]byte, err error) {
-	i64s, err := readDeltaBinaryPackedInt(reader)
+func readDeltaLengthByteArrays(reader *bytes.Reader, count uint64) (result [][]byte, err error) {
+	i64s, err := readDeltaBinaryPackedInt(reader, count)
 	if err != nil {
 		return nil, err
 	}
 
 	for i := 0; i < len(i64s); i++ {
+		if i64s[i] < 0 {
+			return nil, errors.New("parquet: negative byte array length")
+		}
+
 		arrays, err := readFixedLenByteArrays(reader, 1, uint64(i64s[i]))
 		if err != nil {
 			return nil, err
@@ -400,20 +459,31 @@ func readDeltaLengthByteArrays(reader *bytes.Reader) (result [][]byte, err error
 	return result, nil
 }
 
-func readDeltaByteArrays(reader *bytes.Reader) (result [][]byte, err error) {
-	i64s, err := readDeltaBinaryPackedInt(reader)
+func readDeltaByteArrays(reader *bytes.Reader, count uint64) (result [][]byte, err error) {
+	i64s, err := readDeltaBinaryPackedInt(reader, count)
 	if err != nil {
 		return nil, err
 	}
 
-	suffixes, err := readDeltaLengthByteArrays(reader)
+	suffixes, err := readDeltaLengthByteArrays(reader, count)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(suffixes) < len(i64s) {
+		return nil, errors.New("parquet: missing delta byte array suffixes")
+	}
+
+	if len(i64s) == 0 {
+		return nil, nil
+	}
+
 	result = append(result, suffixes[0])
 	for i := 1; i < len(i64s); i++ {
 		prefixLength := i64s[i]
+		if prefixLength < 0 || prefixLength > int64(len(result[i-1])) {
+			return nil, errors.New("parquet: delta byte array prefix length out of range")
+		}
 		val := append([]byte{}, result[i-1][:prefixLength]...)
 		val = append(val, suffixes[i]...)
 		result = append(result, val)
@@ -422,6 +492,23 @@ func readDeltaByteArrays(reader *bytes.Reader) (result [][]byte, err error) {
 	return result, nil
 }
 
+// readByteStreamSplit reads count values of width bytes split into width streams and returns them plain encoded.
+func readByteStreamSplit(reader *bytes.Reader, count, width uint64) ([]byte, error) {
+	data := make([]byte, count*width)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, count*width)
+	for i := uint64(0); i < count; i++ {
+		for j := uint64(0); j < width; j++ {
+			result[i*width+j] = data[j*count+i]
+		}
+	}
+
+	return result, nil
+}
+
 func readDataPageValues(
 	bytesReader *bytes.Reader,
 	encoding parquet.Encoding,
@@ -434,13 +521,13 @@ func readDataPageValues(
 		result, err = readValues(bytesReader, dataType, count, bitWidth)
 		return result, dataType, err
 
-	case parquet.Encoding_PLAIN_DICTIONARY:
+	case parquet.Encoding_PLAIN_DICTIONARY, parquet.Encoding_RLE_DICTIONARY:
 		b, err := bytesReader.ReadByte()
 		if err != nil {
 			return nil, -1, err
 		}
 
-		i64s, err := readRLEBitPackedHybrid(bytesReader, uint64(bytesReader.Len()), uint64(b))
+		i64s, err := readRLEBitPackedHybrid(bytesReader, uint64(bytesReader.Len()), uint64(b), count)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -450,7 +537,7 @@ func readDataPageValues(
 		return i64s[:count], parquet.Type_INT64, nil
 
 	case parquet.Encoding_RLE:
-		i64s, err := readRLEBitPackedHybrid(bytesReader, 0, bitWidth)
+		i64s, err := readRLEBitPackedHybrid(bytesReader, 0, bitWidth, count)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -467,10 +554,10 @@ func readDataPageValues(
 		return i64s, parquet.Type_INT64, nil
 
 	case parquet.Encoding_BIT_PACKED:
-		return nil, -1, fmt.Errorf("deprecated parquet encoding %v", parquet.Encoding_BIT_PACKED)
+		return nil, -1, fmt.Errorf("%w: deprecated parquet encoding %v", ErrUnsupportedEncoding, parquet.Encoding_BIT_PACKED)
 
 	case parquet.Encoding_DELTA_BINARY_PACKED:
-		i64s, err := readDeltaBinaryPackedInt(bytesReader)
+		i64s, err := readDeltaBinaryPackedInt(bytesReader, count)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -487,7 +574,7 @@ func readDataPageValues(
 		return i64s, parquet.Type_INT64, nil
 
 	case parquet.Encoding_DELTA_LENGTH_BYTE_ARRAY:
-		byteSlices, err := readDeltaLengthByteArrays(bytesReader)
+		byteSlices, err := readDeltaLengthByteArrays(bytesReader, count)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -498,7 +585,7 @@ func readDataPageValues(
 		return byteSlices[:count], parquet.Type_FIXED_LEN_BYTE_ARRAY, nil
 
 	case parquet.Encoding_DELTA_BYTE_ARRAY:
-		byteSlices, err := readDeltaByteArrays(bytesReader)
+		byteSlices, err := readDeltaByteArrays(bytesReader, count)
 		if err != nil {
 			return nil, -1, err
 		}
@@ -507,7 +594,32 @@ func readDataPageValues(
 		}
 
 		return byteSlices[:count], parquet.Type_FIXED_LEN_BYTE_ARRAY, nil
+
+	case parquet.Encoding_BYTE_STREAM_SPLIT:
+		var width uint64
+		switch dataType {
+		case parquet.Type_INT32, parquet.Type_FLOAT:
+			width = 4
+		case parquet.Type_INT64, parquet.Type_DOUBLE:
+			width = 8
+		case parquet.Type_FIXED_LEN_BYTE_ARRAY:
+			width = bitWidth
+		default:
+			return nil, -1, fmt.Errorf("%w: parquet encoding %v is not supported for type %v", ErrUnsupportedEncoding, encoding, dataType)
+		}
+
+		if width == 0 || count > uint64(bytesReader.Len())/width {
+			return nil, -1, errors.New("parquet: value out of range")
+		}
+
+		data, err := readByteStreamSplit(bytesReader, count, width)
+		if err != nil {
+			return nil, -1, err
+		}
+
+		result, err = readValues(bytes.NewReader(data), dataType, count, width)
+		return result, dataType, err
 	}
 
-	return nil, -1, fmt.Errorf("unsupported parquet encoding %v", encoding)
+	return nil, -1, fmt.Errorf("%w: parquet encoding %v", ErrUnsupportedEncoding, encoding)
 }
@@ -0,0 +1,74 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+func TestReadDataPageValuesByteStreamSplit(t *testing.T) {
+	testCases := []struct {
+		data           []byte
+		dataType       parquet.Type
+		count          uint64
+		typeLength     uint64
+		expectedResult interface{}
+		expectErr      bool
+	}{
+		{[]byte{0, 0, 0, 0, 128, 0, 63, 64}, parquet.Type_FLOAT, 2, 0, []float32{1, 2}, false},
+		{[]byte{0, 0, 0, 0, 0, 0, 240, 63}, parquet.Type_DOUBLE, 1, 0, []float64{1}, false},
+		{[]byte{1, 5, 2, 6, 3, 7, 4, 8}, parquet.Type_INT32, 2, 0, []int32{0x04030201, 0x08070605}, false},
+		{[]byte("adbecf"), parquet.Type_FIXED_LEN_BYTE_ARRAY, 2, 3, [][]byte{[]byte("abc"), []byte("def")}, false},
+		{[]byte{0, 0, 0, 0, 128, 0, 63}, parquet.Type_FLOAT, 2, 0, nil, true},
+		{[]byte("abc"), parquet.Type_BYTE_ARRAY, 1, 0, nil, true},
+	}
+
+	for i, testCase := range testCases {
+		result, _, err := readDataPageValues(bytes.NewReader(testCase.data), parquet.Encoding_BYTE_STREAM_SPLIT,
+			testCase.dataType, -1, testCase.count, testCase.typeLength)
+		if expectErr := (err != nil); expectErr != testCase.expectErr {
+			t.Fatalf("case %v: expected error: %v, got: %v", i+1, testCase.expectErr, err)
+		}
+
+		if !testCase.expectErr && !reflect.DeepEqual(result, testCase.expectedResult) {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestReadDataPageValuesUnsupportedEncoding(t *testing.T) {
+	testCases := []struct {
+		encoding parquet.Encoding
+		dataType parquet.Type
+	}{
+		{parquet.Encoding_BIT_PACKED, parquet.Type_INT32},
+		{parquet.Encoding_BYTE_STREAM_SPLIT, parquet.Type_BYTE_ARRAY},
+		{parquet.Encoding(100), parquet.Type_INT32},
+	}
+
+	for i, testCase := range testCases {
+		_, _, err := readDataPageValues(bytes.NewReader([]byte{0, 0, 0, 0}), testCase.encoding, testCase.dataType, -1, 1, 0)
+		if !errors.Is(err, ErrUnsupportedEncoding) {
+			t.Fatalf("case %v: err: expected: %v, got: %v", i+1, ErrUnsupportedEncoding, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoding
+
+import (
+	"fmt"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// byteStreamSplit scatters bytes of plain encoded values of width bytes into width streams and concatenates them.
+func byteStreamSplit(data []byte, width int) []byte {
+	count := len(data) / width
+	result := make([]byte, count*width)
+	for i := 0; i < count; i++ {
+		for j := 0; j < width; j++ {
+			result[j*count+i] = data[i*width+j]
+		}
+	}
+
+	return result
+}
+
+// ByteStreamSplitEncode encodes values specified in https://github.com/apache/parquet-format/blob/master/Encodings.md#byte-stream-split-byte_stream_split--9
+//
+// Supported Types: INT32, INT64, FLOAT, DOUBLE, FIXED_LEN_BYTE_ARRAY
+func ByteStreamSplitEncode(values interface{}, parquetType parquet.Type) []byte {
+	switch parquetType {
+	case parquet.Type_INT32, parquet.Type_FLOAT:
+		return byteStreamSplit(PlainEncode(values, parquetType), 4)
+	case parquet.Type_INT64, parquet.Type_DOUBLE:
+		return byteStreamSplit(PlainEncode(values, parquetType), 8)
+	case parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		bytesSlices, ok := values.([][]byte)
+		if !ok {
+			panic(fmt.Errorf("expected slice of byte array"))
+		}
+
+		if len(bytesSlices) == 0 {
+			return nil
+		}
+
+		width := len(bytesSlices[0])
+		data := make([]byte, 0, len(bytesSlices)*width)
+		for _, s := range bytesSlices {
+			if len(s) != width {
+				panic(fmt.Errorf("fixed length byte array values must be of same length"))
+			}
+			data = append(data, s...)
+		}
+
+		return byteStreamSplit(data, width)
+	}
+
+	panic(fmt.Errorf("%v parquet type unsupported", parquetType))
+}
@@ -0,0 +1,46 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encoding
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+func TestByteStreamSplitEncode(t *testing.T) {
+	testCases := []struct {
+		values         interface{}
+		parquetType    parquet.Type
+		expectedResult []byte
+	}{
+		{[]float32{}, parquet.Type_FLOAT, []byte{}},
+		{[]float32{1, 2}, parquet.Type_FLOAT, []byte{0, 0, 0, 0, 128, 0, 63, 64}},
+		{[]int32{0x04030201, 0x08070605}, parquet.Type_INT32, []byte{1, 5, 2, 6, 3, 7, 4, 8}},
+		{[]float64{1}, parquet.Type_DOUBLE, []byte{0, 0, 0, 0, 0, 0, 240, 63}},
+		{[]int64{1, 2}, parquet.Type_INT64, []byte{1, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{[][]byte{[]byte("abc"), []byte("def")}, parquet.Type_FIXED_LEN_BYTE_ARRAY, []byte("adbecf")},
+	}
+
+	for i, testCase := range testCases {
+		result := ByteStreamSplitEncode(testCase.values, testCase.parquetType)
+		if !reflect.DeepEqual(result, testCase.expectedResult) {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
@@ -51,10 +51,13 @@ func RLEDictEncode(values []interface{}, parquetType parquet.Type, bitWidth int3
 		}
 	}
 
-	indexBitWidth = uint8(common.BitWidth(uint64(indices[len(indices)-1])))
+	if len(definedValues) > 1 {
+		indexBitWidth = uint8(common.BitWidth(uint64(len(definedValues) - 1)))
+	}
 
 	dictPageData = PlainEncode(common.ToSliceValue(definedValues, parquetType), parquetType)
-	dataPageData = RLEBitPackedHybridEncode(indices, int32(indexBitWidth), parquet.Type_INT32)
+	// Indices in data page are not prefixed by their length.
+	dataPageData = RLEBitPackedHybridEncode(indices, int32(indexBitWidth), parquet.Type_INT32)[4:]
 
 	return dictPageData, dataPageData, int32(len(definedValues)), indexBitWidth
 }
@@ -0,0 +1,423 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/minio/parquet-go/encryption"
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+// EncryptionAlgorithm - denotes algorithm of parquet modular encryption.
+type EncryptionAlgorithm int
+
+// Supported encryption algorithms.
+const (
+	AESGCMV1    EncryptionAlgorithm = iota // Encrypts all modules by AES-GCM.
+	AESGCMCTRV1                            // Encrypts pages by AES-CTR and other modules by AES-GCM.
+)
+
+// ColumnEncryptionKey - denotes key of an encrypted column.
+type ColumnEncryptionKey struct {
+	Key         []byte // Optional; footer key is used if not set.
+	KeyMetadata []byte // Optional; passed to KeyRetriever by readers.
+}
+
+// FileEncryptionProperties - denotes encryption properties of a file written by Writer.
+type FileEncryptionProperties struct {
+	Algorithm         EncryptionAlgorithm
+	FooterKey         []byte // AES key of 16, 24 or 32 bytes.
+	FooterKeyMetadata []byte // Optional; passed to KeyRetriever by readers.
+	PlaintextFooter   bool   // Writes footer in plaintext signed by footer key, so that legacy readers may read plaintext columns.
+
+	// Encryption keys by column path in schema. If empty, all columns are encrypted
+	// with footer key; otherwise columns not in the map are written in plaintext.
+	ColumnKeys map[string]ColumnEncryptionKey
+
+	AADPrefix       []byte // Optional; prefix of additional authenticated data of every module.
+	SupplyAADPrefix bool   // Does not store AADPrefix in the file; readers must supply it.
+}
+
+// KeyRetriever - retrieves decryption key by key metadata stored in a file.
+type KeyRetriever interface {
+	RetrieveKey(keyMetadata []byte) ([]byte, error)
+}
+
+// KeyRetrieverFunc - adapts function to KeyRetriever.
+type KeyRetrieverFunc func(keyMetadata []byte) ([]byte, error)
+
+// RetrieveKey - calls retrieverFunc(keyMetadata).
+func (retrieverFunc KeyRetrieverFunc) RetrieveKey(keyMetadata []byte) ([]byte, error) {
+	return retrieverFunc(keyMetadata)
+}
+
+// FileDecryptionProperties - denotes decryption properties of a file read by Reader.
+type FileDecryptionProperties struct {
+	KeyRetriever KeyRetriever
+	AADPrefix    []byte // Required if AAD prefix is not stored in the file.
+}
+
+func serializeThrift(tStruct thrift.TStruct) ([]byte, error) {
+	ts := thrift.NewTSerializer()
+	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+	return ts.Write(context.TODO(), tStruct)
+}
+
+// readThriftStruct decodes tStruct from the beginning of data and returns its size in bytes.
+func readThriftStruct(data []byte, tStruct thrift.TStruct) (int, error) {
+	buf := thrift.NewTMemoryBufferLen(len(data))
+	if _, err := buf.Write(data); err != nil {
+		return 0, err
+	}
+
+	// Container sizes are limited to data length as each element takes at least a byte.
+	maxSize := int64(len(data)) + 1
+	if maxSize > math.MaxInt32 {
+		maxSize = math.MaxInt32
+	}
+	protocol := thrift.NewTCompactProtocolConf(buf, &thrift.TConfiguration{MaxMessageSize: int32(maxSize)})
+	if err := tStruct.Read(context.TODO(), protocol); err != nil {
+		return 0, err
+	}
+
+	return len(data) - buf.Len(), nil
+}
+
+// columnPath returns path in schema of column chunk whose metadata may be encrypted.
+func columnPath(columnChunk *parquet.ColumnChunk) []string {
+	if meta := columnChunk.GetMetaData(); meta != nil {
+		return meta.GetPathInSchema()
+	}
+
+	if cryptoMeta := columnChunk.GetCryptoMetadata(); cryptoMeta != nil && cryptoMeta.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		return cryptoMeta.ENCRYPTION_WITH_COLUMN_KEY.GetPathInSchema()
+	}
+
+	return nil
+}
+
+// fileEncryptor - encrypts modules of a file as per FileEncryptionProperties.
+type fileEncryptor struct {
+	props         *FileEncryptionProperties
+	algorithm     *parquet.EncryptionAlgorithm
+	footerCipher  *encryption.Cipher
+	columnCiphers map[string]*encryption.Cipher
+}
+
+func newFileEncryptor(props *FileEncryptionProperties) (*fileEncryptor, error) {
+	var ctr bool
+	switch props.Algorithm {
+	case AESGCMV1:
+	case AESGCMCTRV1:
+		ctr = true
+	default:
+		return nil, fmt.Errorf("unknown encryption algorithm %v", props.Algorithm)
+	}
+
+	algorithm, err := encryption.NewAlgorithm(ctr, props.AADPrefix, props.SupplyAADPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	footerCipher, err := encryption.NewCipher(algorithm, props.FooterKey, props.AADPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("footer key: %w", err)
+	}
+
+	columnCiphers := make(map[string]*encryption.Cipher)
+	for name, columnKey := range props.ColumnKeys {
+		if columnKey.Key == nil {
+			columnCiphers[name] = footerCipher
+			continue
+		}
+
+		if columnCiphers[name], err = encryption.NewCipher(algorithm, columnKey.Key, props.AADPrefix); err != nil {
+			return nil, fmt.Errorf("column %v key: %w", name, err)
+		}
+	}
+
+	return &fileEncryptor{
+		props:         props,
+		algorithm:     algorithm,
+		footerCipher:  footerCipher,
+		columnCiphers: columnCiphers,
+	}, nil
+}
+
+// columnCipher returns cipher of column name or nil if the column is written in plaintext.
+func (encryptor *fileEncryptor) columnCipher(name string) *encryption.Cipher {
+	if encryptor == nil {
+		return nil
+	}
+
+	if len(encryptor.props.ColumnKeys) == 0 {
+		return encryptor.footerCipher
+	}
+
+	return encryptor.columnCiphers[name]
+}
+
+// encryptColumnChunk sets crypto metadata of column chunk. Metadata of column encrypted with
+// its own key is encrypted; it is removed from footer or, for plaintext footer, stripped of statistics.
+func (encryptor *fileEncryptor) encryptColumnChunk(columnChunk *parquet.ColumnChunk, rowGroupOrdinal, columnOrdinal int16) error {
+	name := schema.JoinPath(columnChunk.MetaData.PathInSchema)
+	cipher := encryptor.columnCipher(name)
+	if cipher == nil {
+		return nil
+	}
+
+	columnKey, found := encryptor.props.ColumnKeys[name]
+	if !found || columnKey.Key == nil {
+		columnChunk.CryptoMetadata = &parquet.ColumnCryptoMetaData{
+			ENCRYPTION_WITH_FOOTER_KEY: parquet.NewEncryptionWithFooterKey(),
+		}
+		return nil
+	}
+
+	columnChunk.CryptoMetadata = &parquet.ColumnCryptoMetaData{
+		ENCRYPTION_WITH_COLUMN_KEY: &parquet.EncryptionWithColumnKey{
+			PathInSchema: columnChunk.MetaData.PathInSchema,
+			KeyMetadata:  columnKey.KeyMetadata,
+		},
+	}
+
+	data, err := serializeThrift(columnChunk.MetaData)
+	if err != nil {
+		return err
+	}
+
+	if columnChunk.EncryptedColumnMetadata, err = cipher.Encrypt(encryption.ColumnMetaData, rowGroupOrdinal, columnOrdinal, 0, data); err != nil {
+		return err
+	}
+
+	if encryptor.props.PlaintextFooter {
+		meta := *columnChunk.MetaData
+		meta.Statistics = nil
+		columnChunk.MetaData = &meta
+	} else {
+		columnChunk.MetaData = nil
+	}
+
+	return nil
+}
+
+// encryptFooter returns serialized footer. Encrypted footer is preceded by FileCryptoMetaData;
+// plaintext footer is followed by its signature.
+func (encryptor *fileEncryptor) encryptFooter(footer *parquet.FileMetaData) ([]byte, error) {
+	if encryptor.props.PlaintextFooter {
+		footer.EncryptionAlgorithm = encryptor.algorithm
+		footer.FooterSigningKeyMetadata = encryptor.props.FooterKeyMetadata
+
+		data, err := serializeThrift(footer)
+		if err != nil {
+			return nil, err
+		}
+
+		signature, err := encryptor.footerCipher.Sign(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(data, signature...), nil
+	}
+
+	cryptoMeta := parquet.NewFileCryptoMetaData()
+	cryptoMeta.EncryptionAlgorithm = encryptor.algorithm
+	cryptoMeta.KeyMetadata = encryptor.props.FooterKeyMetadata
+	cryptoMetaData, err := serializeThrift(cryptoMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := serializeThrift(footer)
+	if err != nil {
+		return nil, err
+	}
+
+	module, err := encryptor.footerCipher.Encrypt(encryption.Footer, 0, 0, 0, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(cryptoMetaData, module...), nil
+}
+
+// fileDecryptor - decrypts modules of a file with keys retrieved by FileDecryptionProperties.KeyRetriever.
+type fileDecryptor struct {
+	props             *FileDecryptionProperties
+	algorithm         *parquet.EncryptionAlgorithm
+	footerKeyMetadata []byte
+	ciphers           map[string]*encryption.Cipher
+	errs              map[string]error
+}
+
+func newFileDecryptor(props *FileDecryptionProperties, algorithm *parquet.EncryptionAlgorithm, footerKeyMetadata []byte) (*fileDecryptor, error) {
+	if props.KeyRetriever == nil {
+		return nil, errors.New("key retriever not set")
+	}
+
+	return &fileDecryptor{
+		props:             props,
+		algorithm:         algorithm,
+		footerKeyMetadata: footerKeyMetadata,
+		ciphers:           make(map[string]*encryption.Cipher),
+		errs:              make(map[string]error),
+	}, nil
+}
+
+// cipher returns cipher of key retrieved by keyMetadata. Ciphers and retrieval errors are cached.
+func (decryptor *fileDecryptor) cipher(keyMetadata []byte) (*encryption.Cipher, error) {
+	if cipher, found := decryptor.ciphers[string(keyMetadata)]; found {
+		return cipher, nil
+	}
+
+	if err, found := decryptor.errs[string(keyMetadata)]; found {
+		return nil, err
+	}
+
+	var cipher *encryption.Cipher
+	key, err := decryptor.props.KeyRetriever.RetrieveKey(keyMetadata)
+	if err == nil {
+		cipher, err = encryption.NewCipher(decryptor.algorithm, key, decryptor.props.AADPrefix)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("unable to retrieve key: %w", err)
+		decryptor.errs[string(keyMetadata)] = err
+		return nil, err
+	}
+
+	decryptor.ciphers[string(keyMetadata)] = cipher
+	return cipher, nil
+}
+
+func (decryptor *fileDecryptor) footerCipher() (*encryption.Cipher, error) {
+	return decryptor.cipher(decryptor.footerKeyMetadata)
+}
+
+// columnCipher returns cipher of encrypted column chunk.
+func (decryptor *fileDecryptor) columnCipher(columnChunk *parquet.ColumnChunk) (*encryption.Cipher, error) {
+	if decryptor == nil {
+		return nil, errors.New("column is encrypted; decryption properties not set")
+	}
+
+	if cryptoMeta := columnChunk.GetCryptoMetadata(); cryptoMeta.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		return decryptor.cipher(cryptoMeta.ENCRYPTION_WITH_COLUMN_KEY.GetKeyMetadata())
+	}
+
+	return decryptor.footerCipher()
+}
+
+// decryptColumnMetaData decrypts metadata of columns encrypted with their own keys. Columns
+// whose key is not retrievable are left as is; the error is returned when such column is read.
+func (decryptor *fileDecryptor) decryptColumnMetaData(fileMeta *parquet.FileMetaData) error {
+	for rowGroupOrdinal, rowGroup := range fileMeta.GetRowGroups() {
+		for columnOrdinal, columnChunk := range rowGroup.GetColumns() {
+			if !columnChunk.IsSetEncryptedColumnMetadata() {
+				continue
+			}
+
+			cipher, err := decryptor.columnCipher(columnChunk)
+			if err != nil {
+				continue
+			}
+
+			data, err := cipher.Decrypt(encryption.ColumnMetaData, int16(rowGroupOrdinal), int16(columnOrdinal), 0, columnChunk.EncryptedColumnMetadata)
+			if err != nil {
+				return err
+			}
+
+			meta := parquet.NewColumnMetaData()
+			if _, err = readThriftStruct(data, meta); err != nil {
+				return err
+			}
+
+			columnChunk.MetaData = meta
+		}
+	}
+
+	return nil
+}
+
+// pageDecryptor - decrypts page headers and pages of a column chunk in order.
+type pageDecryptor struct {
+	cipher          *encryption.Cipher
+	rowGroupOrdinal int16
+	columnOrdinal   int16
+	pageOrdinal     int16
+	dictionaryPage  bool // Whether next page is dictionary page.
+}
+
+func (decryptor *pageDecryptor) readPageHeader(reader io.Reader) (*parquet.PageHeader, error) {
+	moduleType := encryption.DataPageHeader
+	if decryptor.dictionaryPage {
+		moduleType = encryption.DictionaryPageHeader
+	}
+
+	module, err := encryption.ReadModule(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decryptor.cipher.Decrypt(moduleType, decryptor.rowGroupOrdinal, decryptor.columnOrdinal, decryptor.pageOrdinal, module)
+	if err != nil {
+		return nil, &DecryptionError{Err: err}
+	}
+
+	pageHeader := parquet.NewPageHeader()
+	if _, err = readThriftStruct(data, pageHeader); err != nil {
+		return nil, err
+	}
+
+	return pageHeader, nil
+}
+
+// readPage reads and decrypts page. Positive limit restricts encrypted page length to limit bytes.
+func (decryptor *pageDecryptor) readPage(reader io.Reader, limit int64) ([]byte, error) {
+	moduleType := encryption.DataPage
+	if decryptor.dictionaryPage {
+		moduleType = encryption.DictionaryPage
+	}
+
+	module, err := encryption.ReadModuleLimit(reader, limit)
+	if err != nil {
+		if errors.Is(err, encryption.ErrModuleTooLarge) {
+			return nil, fmt.Errorf("%w: page %v", ErrLimitExceeded, err)
+		}
+		return nil, err
+	}
+
+	data, err := decryptor.cipher.Decrypt(moduleType, decryptor.rowGroupOrdinal, decryptor.columnOrdinal, decryptor.pageOrdinal, module)
+	if err != nil {
+		return nil, &DecryptionError{Err: err}
+	}
+
+	if decryptor.dictionaryPage {
+		decryptor.dictionaryPage = false
+	} else {
+		decryptor.pageOrdinal++
+	}
+
+	return data, nil
+}
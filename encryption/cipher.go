@@ -0,0 +1,284 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// ModuleType - denotes type of encrypted module used in its AAD.
+type ModuleType byte
+
+// Module types defined by parquet modular encryption.
+const (
+	Footer ModuleType = iota
+	ColumnMetaData
+	DataPage
+	DictionaryPage
+	DataPageHeader
+	DictionaryPageHeader
+	ColumnIndex
+	OffsetIndex
+	BloomFilterHeader
+	BloomFilterBitset
+)
+
+const (
+	lengthSize = 4
+	nonceSize  = 12
+	tagSize    = 16
+
+	// SignatureSize - size of footer signature in files with plaintext footer.
+	SignatureSize = nonceSize + tagSize
+
+	// AADFileUniqueSize - size of unique file identifier generated by NewAlgorithm.
+	AADFileUniqueSize = 8
+)
+
+// NewAlgorithm - creates AES_GCM_V1 or AES_GCM_CTR_V1 (if ctr is true) algorithm with random unique file identifier.
+// If supplyAADPrefix is true, aadPrefix is not stored in the file and readers must supply it.
+func NewAlgorithm(ctr bool, aadPrefix []byte, supplyAADPrefix bool) (*parquet.EncryptionAlgorithm, error) {
+	aadFileUnique := make([]byte, AADFileUniqueSize)
+	if _, err := io.ReadFull(rand.Reader, aadFileUnique); err != nil {
+		return nil, err
+	}
+
+	var storedAADPrefix []byte
+	var supplyAADPrefixPtr *bool
+	if len(aadPrefix) != 0 {
+		if supplyAADPrefix {
+			supplyAADPrefixPtr = &supplyAADPrefix
+		} else {
+			storedAADPrefix = aadPrefix
+		}
+	}
+
+	if ctr {
+		return &parquet.EncryptionAlgorithm{
+			AES_GCM_CTR_V1: &parquet.AesGcmCtrV1{
+				AadPrefix:       storedAADPrefix,
+				AadFileUnique:   aadFileUnique,
+				SupplyAadPrefix: supplyAADPrefixPtr,
+			},
+		}, nil
+	}
+
+	return &parquet.EncryptionAlgorithm{
+		AES_GCM_V1: &parquet.AesGcmV1{
+			AadPrefix:       storedAADPrefix,
+			AadFileUnique:   aadFileUnique,
+			SupplyAadPrefix: supplyAADPrefixPtr,
+		},
+	}, nil
+}
+
+// ModuleAAD - returns AAD of a module. Row group, column and page ordinals are used as per module type.
+func ModuleAAD(fileAAD []byte, moduleType ModuleType, rowGroupOrdinal, columnOrdinal, pageOrdinal int16) []byte {
+	aad := append(append([]byte{}, fileAAD...), byte(moduleType))
+	if moduleType == Footer {
+		return aad
+	}
+
+	buf := make([]byte, 2)
+	binary.LittleEndian.PutUint16(buf, uint16(rowGroupOrdinal))
+	aad = append(aad, buf...)
+	binary.LittleEndian.PutUint16(buf, uint16(columnOrdinal))
+	aad = append(aad, buf...)
+
+	switch moduleType {
+	case DataPage, DataPageHeader:
+		binary.LittleEndian.PutUint16(buf, uint16(pageOrdinal))
+		aad = append(aad, buf...)
+	}
+
+	return aad
+}
+
+// ErrModuleTooLarge - denotes module length exceeding limit passed to ReadModuleLimit.
+var ErrModuleTooLarge = errors.New("encrypted module length exceeds limit")
+
+// ReadModule - reads length prefixed module from reader. Returned module includes its length prefix.
+func ReadModule(reader io.Reader) ([]byte, error) {
+	return ReadModuleLimit(reader, 0)
+}
+
+// ReadModuleLimit - reads length prefixed module like ReadModule. Positive limit restricts module length to
+// limit bytes.
+func ReadModuleLimit(reader io.Reader, limit int64) ([]byte, error) {
+	lengthBuf := make([]byte, lengthSize)
+	if _, err := io.ReadFull(reader, lengthBuf); err != nil {
+		return nil, err
+	}
+
+	length := binary.LittleEndian.Uint32(lengthBuf)
+	if length < nonceSize {
+		return nil, fmt.Errorf("invalid encrypted module length %v", length)
+	}
+
+	if limit > 0 && int64(length) > limit {
+		return nil, fmt.Errorf("%w: %v > %v", ErrModuleTooLarge, length, limit)
+	}
+
+	module := make([]byte, lengthSize+int(length))
+	copy(module, lengthBuf)
+	if _, err := io.ReadFull(reader, module[lengthSize:]); err != nil {
+		return nil, err
+	}
+
+	return module, nil
+}
+
+// Cipher - encrypts and decrypts modules of a file with a key.
+type Cipher struct {
+	block   cipher.Block
+	aead    cipher.AEAD
+	fileAAD []byte
+	ctr     bool
+}
+
+// NewCipher - creates cipher of algorithm with AES key of 16, 24 or 32 bytes. aadPrefix is used
+// only if the prefix is not stored in algorithm.
+func NewCipher(algorithm *parquet.EncryptionAlgorithm, key, aadPrefix []byte) (*Cipher, error) {
+	var storedAADPrefix, aadFileUnique []byte
+	var supplyAADPrefix, ctr bool
+	switch {
+	case algorithm.IsSetAES_GCM_V1():
+		storedAADPrefix = algorithm.AES_GCM_V1.AadPrefix
+		aadFileUnique = algorithm.AES_GCM_V1.AadFileUnique
+		supplyAADPrefix = algorithm.AES_GCM_V1.GetSupplyAadPrefix()
+	case algorithm.IsSetAES_GCM_CTR_V1():
+		storedAADPrefix = algorithm.AES_GCM_CTR_V1.AadPrefix
+		aadFileUnique = algorithm.AES_GCM_CTR_V1.AadFileUnique
+		supplyAADPrefix = algorithm.AES_GCM_CTR_V1.GetSupplyAadPrefix()
+		ctr = true
+	default:
+		return nil, fmt.Errorf("unsupported encryption algorithm %v", algorithm)
+	}
+
+	if len(storedAADPrefix) != 0 {
+		aadPrefix = storedAADPrefix
+	} else if supplyAADPrefix && len(aadPrefix) == 0 {
+		return nil, errors.New("AAD prefix is not stored in file and must be supplied")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cipher{
+		block:   block,
+		aead:    aead,
+		fileAAD: append(append([]byte{}, aadPrefix...), aadFileUnique...),
+		ctr:     ctr,
+	}, nil
+}
+
+// isCTR returns whether module of moduleType is encrypted by AES-CTR.
+func (c *Cipher) isCTR(moduleType ModuleType) bool {
+	return c.ctr && (moduleType == DataPage || moduleType == DictionaryPage)
+}
+
+func ctrIV(nonce []byte) []byte {
+	// Initial counter value is one in last four bytes of IV.
+	return append(append([]byte{}, nonce...), 0, 0, 0, 1)
+}
+
+// Encrypt - encrypts data of module and returns length prefixed module.
+func (c *Cipher) Encrypt(moduleType ModuleType, rowGroupOrdinal, columnOrdinal, pageOrdinal int16, data []byte) ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	module := make([]byte, lengthSize+nonceSize, lengthSize+nonceSize+len(data)+tagSize)
+	copy(module[lengthSize:], nonce)
+
+	if c.isCTR(moduleType) {
+		module = module[:lengthSize+nonceSize+len(data)]
+		cipher.NewCTR(c.block, ctrIV(nonce)).XORKeyStream(module[lengthSize+nonceSize:], data)
+	} else {
+		aad := ModuleAAD(c.fileAAD, moduleType, rowGroupOrdinal, columnOrdinal, pageOrdinal)
+		module = c.aead.Seal(module, nonce, data, aad)
+	}
+
+	binary.LittleEndian.PutUint32(module, uint32(len(module)-lengthSize))
+	return module, nil
+}
+
+// Decrypt - decrypts length prefixed module and returns its data.
+func (c *Cipher) Decrypt(moduleType ModuleType, rowGroupOrdinal, columnOrdinal, pageOrdinal int16, module []byte) ([]byte, error) {
+	if len(module) < lengthSize+nonceSize || int(binary.LittleEndian.Uint32(module)) != len(module)-lengthSize {
+		return nil, errors.New("invalid encrypted module length")
+	}
+
+	nonce := module[lengthSize : lengthSize+nonceSize]
+	ciphertext := module[lengthSize+nonceSize:]
+
+	if c.isCTR(moduleType) {
+		data := make([]byte, len(ciphertext))
+		cipher.NewCTR(c.block, ctrIV(nonce)).XORKeyStream(data, ciphertext)
+		return data, nil
+	}
+
+	aad := ModuleAAD(c.fileAAD, moduleType, rowGroupOrdinal, columnOrdinal, pageOrdinal)
+	data, err := c.aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt module type %v: %w", moduleType, err)
+	}
+
+	return data, nil
+}
+
+// Sign - returns signature of plaintext footer.
+func (c *Cipher) Sign(footer []byte) ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	sealed := c.aead.Seal(nil, nonce, footer, ModuleAAD(c.fileAAD, Footer, 0, 0, 0))
+	return append(nonce, sealed[len(sealed)-tagSize:]...), nil
+}
+
+// Verify - verifies signature of plaintext footer.
+func (c *Cipher) Verify(footer, signature []byte) error {
+	if len(signature) != SignatureSize {
+		return errors.New("invalid footer signature size")
+	}
+
+	sealed := c.aead.Seal(nil, signature[:nonceSize], footer, ModuleAAD(c.fileAAD, Footer, 0, 0, 0))
+	if subtle.ConstantTimeCompare(sealed[len(sealed)-tagSize:], signature[nonceSize:]) != 1 {
+		return errors.New("footer signature mismatch")
+	}
+
+	return nil
+}
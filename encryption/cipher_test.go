@@ -0,0 +1,145 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"bytes"
+	"testing"
+)
+
+var testKey = []byte("0123456789abcdef")
+
+func TestCipherEncryptDecrypt(t *testing.T) {
+	testCases := []struct {
+		ctr        bool
+		moduleType ModuleType
+	}{
+		{false, DataPage},
+		{false, DataPageHeader},
+		{false, Footer},
+		{true, DataPage},
+		{true, DictionaryPage},
+		{true, ColumnMetaData},
+	}
+
+	data := []byte("hello, world")
+	for i, testCase := range testCases {
+		algorithm, err := NewAlgorithm(testCase.ctr, []byte("prefix"), false)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		cipher, err := NewCipher(algorithm, testKey, nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		module, err := cipher.Encrypt(testCase.moduleType, 1, 2, 3, data)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		module2, err := ReadModule(bytes.NewReader(module))
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		if !bytes.Equal(module2, module) {
+			t.Fatalf("case %v: module mismatch", i+1)
+		}
+
+		result, err := cipher.Decrypt(testCase.moduleType, 1, 2, 3, module)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		if !bytes.Equal(result, data) {
+			t.Fatalf("case %v: expected: %s, got: %s", i+1, data, result)
+		}
+
+		// AES-CTR encrypted pages are not authenticated.
+		if cipher.isCTR(testCase.moduleType) || testCase.moduleType == Footer {
+			continue
+		}
+
+		if _, err = cipher.Decrypt(testCase.moduleType, 1, 3, 3, module); err == nil {
+			t.Fatalf("case %v: expected: <error>, got: <nil>", i+1)
+		}
+	}
+}
+
+func TestCipherAADPrefix(t *testing.T) {
+	algorithm, err := NewAlgorithm(false, []byte("prefix"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if algorithm.AES_GCM_V1.AadPrefix != nil {
+		t.Fatalf("expected: <nil>, got: %s", algorithm.AES_GCM_V1.AadPrefix)
+	}
+
+	if _, err = NewCipher(algorithm, testKey, nil); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+
+	cipher, err := NewCipher(algorithm, testKey, []byte("prefix"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	module, err := cipher.Encrypt(ColumnMetaData, 0, 0, 0, []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrongCipher, err := NewCipher(algorithm, testKey, []byte("wrong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = wrongCipher.Decrypt(ColumnMetaData, 0, 0, 0, module); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+}
+
+func TestCipherSignVerify(t *testing.T) {
+	algorithm, err := NewAlgorithm(false, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cipher, err := NewCipher(algorithm, testKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	footer := []byte("footer")
+	signature, err := cipher.Sign(footer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(signature) != SignatureSize {
+		t.Fatalf("expected: %v, got: %v", SignatureSize, len(signature))
+	}
+
+	if err = cipher.Verify(footer, signature); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = cipher.Verify([]byte("Footer"), signature); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+}
@@ -0,0 +1,285 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+var (
+	testFooterKey = []byte("0123456789abcdef")
+	testColumnKey = []byte("fedcba9876543210")
+)
+
+// envKeyRetriever - retrieves hex encoded key from environment variable named by key metadata.
+var envKeyRetriever = KeyRetrieverFunc(func(keyMetadata []byte) ([]byte, error) {
+	value, found := os.LookupEnv(string(keyMetadata))
+	if !found {
+		return nil, fmt.Errorf("environment variable %s not set", keyMetadata)
+	}
+
+	return hex.DecodeString(value)
+})
+
+func writeEncryptedFile(t *testing.T, props *FileEncryptionProperties) []byte {
+	schemaTree := schema.NewTree()
+	{
+		id, err := schema.NewElement("id", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_INT64), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id.BloomFilter = true
+
+		name, err := schema.NewElement("name", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_BYTE_ARRAY), parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8),
+			nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name.BloomFilter = true
+		name.Encoding = parquet.EncodingPtr(parquet.Encoding_PLAIN)
+
+		if err := schemaTree.Set("id", id); err != nil {
+			t.Fatal(err)
+		}
+		if err := schemaTree.Set("name", name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewEncryptedWriter(buf, schemaTree, 10, props)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.PageChecksum = true
+
+	for i := 0; i < 30; i++ {
+		if err = writer.WriteJSON([]byte(fmt.Sprintf(`{"id": %v, "name": "name-%v"}`, i, i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.SetColumnKeyValueMetadata("name", "key", "value"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func countRecords(reader *Reader) (count int, err error) {
+	defer reader.Close()
+
+	for {
+		if _, err = reader.Read(); err != nil {
+			if err == io.EOF {
+				return count, nil
+			}
+			return count, err
+		}
+		count++
+	}
+}
+
+func TestEncryptedReaderWriter(t *testing.T) {
+	os.Setenv("PARQUET_GO_TEST_FOOTER_KEY", hex.EncodeToString(testFooterKey))
+	os.Setenv("PARQUET_GO_TEST_COLUMN_KEY", hex.EncodeToString(testColumnKey))
+	defer os.Unsetenv("PARQUET_GO_TEST_FOOTER_KEY")
+	defer os.Unsetenv("PARQUET_GO_TEST_COLUMN_KEY")
+
+	footerKeyProps := func(algorithm EncryptionAlgorithm, plaintextFooter bool) *FileEncryptionProperties {
+		return &FileEncryptionProperties{
+			Algorithm:         algorithm,
+			FooterKey:         testFooterKey,
+			FooterKeyMetadata: []byte("PARQUET_GO_TEST_FOOTER_KEY"),
+			PlaintextFooter:   plaintextFooter,
+		}
+	}
+
+	columnKeyProps := func(algorithm EncryptionAlgorithm, plaintextFooter bool) *FileEncryptionProperties {
+		props := footerKeyProps(algorithm, plaintextFooter)
+		props.ColumnKeys = map[string]ColumnEncryptionKey{
+			"name": {Key: testColumnKey, KeyMetadata: []byte("PARQUET_GO_TEST_COLUMN_KEY")},
+		}
+		return props
+	}
+
+	testCases := []struct {
+		props         *FileEncryptionProperties
+		expectedMagic string
+	}{
+		{footerKeyProps(AESGCMV1, false), "PARE"},
+		{footerKeyProps(AESGCMCTRV1, false), "PARE"},
+		{footerKeyProps(AESGCMV1, true), "PAR1"},
+		{columnKeyProps(AESGCMV1, false), "PARE"},
+		{columnKeyProps(AESGCMCTRV1, false), "PARE"},
+		{columnKeyProps(AESGCMCTRV1, true), "PAR1"},
+	}
+
+	for i, testCase := range testCases {
+		data := writeEncryptedFile(t, testCase.props)
+		if magic := string(data[len(data)-4:]); magic != testCase.expectedMagic {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedMagic, magic)
+		}
+		if bytes.Contains(data, []byte("name-15")) {
+			t.Fatalf("case %v: plaintext value found in encrypted file", i+1)
+		}
+
+		reader, err := NewEncryptedReader(bytesGetReaderFunc(data), nil, &FileDecryptionProperties{KeyRetriever: envKeyRetriever})
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		for rowGroupIndex := 0; rowGroupIndex < 3; rowGroupIndex++ {
+			for _, name := range []string{"id", "name"} {
+				filter, err := reader.BloomFilter(rowGroupIndex, name)
+				if err != nil {
+					t.Fatalf("case %v: %v", i+1, err)
+				}
+				if filter == nil {
+					t.Fatalf("case %v: row group %v: expected: <filter>, got: <nil>", i+1, rowGroupIndex)
+				}
+			}
+		}
+
+		keyValues, err := reader.ColumnKeyValueMetadata(0, "name")
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		if keyValues["key"] != "value" {
+			t.Fatalf("case %v: expected: value, got: %v", i+1, keyValues["key"])
+		}
+
+		if err = reader.SetEqualityPredicate("name", "name-15"); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		count, err := countRecords(reader)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		if count != 10 {
+			t.Fatalf("case %v: expected: 10, got: %v", i+1, count)
+		}
+	}
+}
+
+func TestEncryptedReaderPlaintextFooter(t *testing.T) {
+	data := writeEncryptedFile(t, &FileEncryptionProperties{
+		FooterKey:       testFooterKey,
+		PlaintextFooter: true,
+		ColumnKeys: map[string]ColumnEncryptionKey{
+			"name": {Key: testColumnKey, KeyMetadata: []byte("column")},
+		},
+	})
+
+	// Plaintext column is readable without decryption properties.
+	reader, err := NewReader(bytesGetReaderFunc(data), set.CreateStringSet("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := countRecords(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 30 {
+		t.Fatalf("expected: 30, got: %v", count)
+	}
+
+	// Encrypted column is not readable without its key.
+	reader, err = NewReader(bytesGetReaderFunc(data), set.CreateStringSet("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = countRecords(reader); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+
+	// Footer signature is verified.
+	tampered := append([]byte{}, data...)
+	tampered[len(tampered)-9] ^= 1
+	footerOnly := KeyRetrieverFunc(func(keyMetadata []byte) ([]byte, error) {
+		if len(keyMetadata) == 0 {
+			return testFooterKey, nil
+		}
+		return nil, fmt.Errorf("unknown key %s", keyMetadata)
+	})
+
+	if _, err = NewEncryptedReader(bytesGetReaderFunc(tampered), nil, &FileDecryptionProperties{KeyRetriever: footerOnly}); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+
+	// Column without retrievable key fails on read only.
+	reader, err = NewEncryptedReader(bytesGetReaderFunc(data), nil, &FileDecryptionProperties{KeyRetriever: footerOnly})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = countRecords(reader); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+}
+
+func TestEncryptedReaderErrors(t *testing.T) {
+	keyRetriever := KeyRetrieverFunc(func(keyMetadata []byte) ([]byte, error) {
+		return testFooterKey, nil
+	})
+	wrongKeyRetriever := KeyRetrieverFunc(func(keyMetadata []byte) ([]byte, error) {
+		return testColumnKey, nil
+	})
+
+	data := writeEncryptedFile(t, &FileEncryptionProperties{
+		FooterKey:       testFooterKey,
+		AADPrefix:       []byte("table/part-0"),
+		SupplyAADPrefix: true,
+	})
+
+	testCases := []struct {
+		props       *FileDecryptionProperties
+		expectedErr bool
+	}{
+		{nil, true},
+		{&FileDecryptionProperties{}, true},
+		{&FileDecryptionProperties{KeyRetriever: keyRetriever}, true},
+		{&FileDecryptionProperties{KeyRetriever: keyRetriever, AADPrefix: []byte("table/part-1")}, true},
+		{&FileDecryptionProperties{KeyRetriever: wrongKeyRetriever, AADPrefix: []byte("table/part-0")}, true},
+		{&FileDecryptionProperties{KeyRetriever: keyRetriever, AADPrefix: []byte("table/part-0")}, false},
+	}
+
+	for i, testCase := range testCases {
+		_, err := NewEncryptedReader(bytesGetReaderFunc(data), nil, testCase.props)
+		if expectErr := (err != nil); expectErr != testCase.expectedErr {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedErr, err)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrCorruptPage - denotes page whose header or data is not decodable.
+	ErrCorruptPage = errors.New("parquet: corrupt page")
+
+	// ErrUnsupportedEncoding - denotes page of encoding, compression codec or page type not supported by the reader.
+	ErrUnsupportedEncoding = errors.New("parquet: unsupported encoding")
+
+	// ErrTruncated - denotes column chunk or page shorter than its size in metadata or header, or failed range fetch.
+	ErrTruncated = errors.New("parquet: truncated data")
+
+	// ErrNotParquet - denotes object which is not a parquet file.
+	ErrNotParquet = errors.New("parquet: not a parquet file")
+
+	// ErrLimitExceeded - denotes footer, schema, row groups or page exceeding reader's Limits.
+	ErrLimitExceeded = errors.New("parquet: limit exceeded")
+
+	// ErrCursorMismatch - denotes cursor passed to Resume which is not of the file.
+	ErrCursorMismatch = errors.New("parquet: cursor of another file")
+
+	// ErrSplitMismatch - denotes split whose offset and length are not of its row groups in the file.
+	ErrSplitMismatch = errors.New("parquet: split of another file")
+
+	// ErrInvalidRange - denotes range not satisfiable by the file. GetReaderFunc rejecting range beyond start of
+	// the file returns error wrapping it, hence the footer is fetched by smaller requests.
+	ErrInvalidRange = errors.New("parquet: invalid range")
+)
+
+// ChecksumError - denotes page whose CRC32 checksum does not match with checksum in its header.
+type ChecksumError struct {
+	Column     string // Column path.
+	RowGroup   int    // Row group index.
+	PageOffset int64  // File offset of page header.
+	Expected   uint32 // Checksum in page header.
+	Actual     uint32 // Checksum of page data.
+}
+
+func (err *ChecksumError) Error() string {
+	return fmt.Sprintf("parquet: corrupted page of column %v in row group %v at offset %v: expected checksum %08x, got %08x",
+		err.Column, err.RowGroup, err.PageOffset, err.Expected, err.Actual)
+}
+
+// DecryptionError - denotes page or page header which is not decryptable.
+type DecryptionError struct {
+	Column     string // Column path.
+	RowGroup   int    // Row group index.
+	PageOffset int64  // File offset of page header.
+	Err        error  // Underlying error.
+}
+
+func (err *DecryptionError) Error() string {
+	return fmt.Sprintf("parquet: unable to decrypt page of column %v in row group %v at offset %v: %v",
+		err.Column, err.RowGroup, err.PageOffset, err.Err)
+}
+
+// Unwrap - returns underlying error.
+func (err *DecryptionError) Unwrap() error {
+	return err.Err
+}
+
+// SchemaMismatchError - denotes file which is not readable as target schema set by SetTargetSchema.
+type SchemaMismatchError struct {
+	Column string // Column path in target schema.
+	Reason string // Why the file column is not readable.
+}
+
+func (err *SchemaMismatchError) Error() string {
+	return fmt.Sprintf("parquet: column %v of target schema is not readable: %v", err.Column, err.Reason)
+}
+
+// PageError - denotes page of a column which is not readable. errors.Is reports whether Kind is
+// ErrCorruptPage, ErrUnsupportedEncoding, ErrTruncated or ErrLimitExceeded.
+type PageError struct {
+	Kind       error  // One of ErrCorruptPage, ErrUnsupportedEncoding, ErrTruncated or ErrLimitExceeded.
+	Column     string // Column path.
+	RowGroup   int    // Row group index.
+	PageOffset int64  // File offset of page header.
+	Row        int64  // Index of first row of the page in the file.
+	Err        error  // Underlying error.
+}
+
+func (err *PageError) Error() string {
+	page := fmt.Sprintf("page of column %v in row group %v at offset %v, row %v", err.Column, err.RowGroup, err.PageOffset, err.Row)
+	if errors.Is(err.Err, err.Kind) {
+		return fmt.Sprintf("parquet: %v: %v", page, err.Err)
+	}
+
+	return fmt.Sprintf("%v: %v: %v", err.Kind, page, err.Err)
+}
+
+// Is - returns whether target is Kind of this error.
+func (err *PageError) Is(target error) bool {
+	return target == err.Kind
+}
+
+// Unwrap - returns underlying error.
+func (err *PageError) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,148 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+// writePageErrorFile writes file of GZIP compressed column a of rows 1 to 4 in two row groups.
+func writePageErrorFile(t testing.TB) []byte {
+	a, err := schema.NewElement("a", parquet.FieldRepetitionType_OPTIONAL,
+		parquet.TypePtr(parquet.Type_INT32), nil, parquet.EncodingPtr(parquet.Encoding_PLAIN),
+		parquet.CompressionCodecPtr(parquet.CompressionCodec_GZIP), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaTree := schema.NewTree()
+	if err = schemaTree.Set("a", a); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i <= 4; i++ {
+		if err = writer.WriteJSON([]byte(fmt.Sprintf(`{"a": %v}`, i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestReaderPageError(t *testing.T) {
+	data := writePageErrorFile(t)
+
+	reader, err := NewReader(bytesGetReaderFunc(data), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := reader.rowGroups[1].Columns[0].MetaData
+	chunkOffset, chunkSize := meta.GetDataPageOffset(), meta.GetTotalCompressedSize()
+	reader.Close()
+
+	// Last byte of the chunk is of GZIP trailer of second row group's page.
+	corrupted := append([]byte{}, data...)
+	corrupted[chunkOffset+chunkSize-1] ^= 0xff
+
+	truncatedGetReaderFunc := func(offset, length int64) (io.ReadCloser, error) {
+		if offset == chunkOffset {
+			return ioutil.NopCloser(bytes.NewReader(data[offset : offset+length/2])), nil
+		}
+
+		return bytesGetReaderFunc(data)(offset, length)
+	}
+
+	testCases := []struct {
+		getReaderFunc  GetReaderFunc
+		lenient        bool
+		expectedResult string
+		expectedErr    error
+		expectedSkip   error
+	}{
+		{bytesGetReaderFunc(data), false, "[1 2 3 4]", nil, nil},
+		{bytesGetReaderFunc(corrupted), false, "[1 2]", ErrCorruptPage, nil},
+		{truncatedGetReaderFunc, false, "[1 2]", ErrTruncated, nil},
+		{bytesGetReaderFunc(corrupted), true, "[1 2 <nil> <nil>]", nil, ErrCorruptPage},
+		{truncatedGetReaderFunc, true, "[1 2 <nil> <nil>]", nil, ErrTruncated},
+	}
+
+	for i, testCase := range testCases {
+		reader, err := NewReader(testCase.getReaderFunc, nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		reader.Lenient = testCase.lenient
+
+		var result []interface{}
+		for {
+			var record *Record
+			if record, err = reader.Read(); err != nil {
+				break
+			}
+
+			value, _ := record.Get("a")
+			result = append(result, value.Value)
+		}
+		reader.Close()
+
+		if fmt.Sprint(result) != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+
+		if testCase.expectedErr == nil {
+			if err != io.EOF {
+				t.Fatalf("case %v: err: expected: %v, got: %v", i+1, io.EOF, err)
+			}
+		} else {
+			var pageErr *PageError
+			if !errors.Is(err, testCase.expectedErr) || !errors.As(err, &pageErr) {
+				t.Fatalf("case %v: err: expected: %v, got: %v", i+1, testCase.expectedErr, err)
+			}
+
+			if pageErr.Column != "a" || pageErr.RowGroup != 1 || pageErr.PageOffset != chunkOffset || pageErr.Row != 2 {
+				t.Fatalf("case %v: unexpected error %v", i+1, pageErr)
+			}
+		}
+
+		skipped := reader.SkippedPages()
+		if testCase.expectedSkip == nil {
+			if len(skipped) != 0 {
+				t.Fatalf("case %v: skipped pages: expected: [], got: %v", i+1, skipped)
+			}
+		} else if len(skipped) != 1 || !errors.Is(skipped[0], testCase.expectedSkip) {
+			t.Fatalf("case %v: skipped pages: expected: [%v], got: %v", i+1, testCase.expectedSkip, skipped)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// MarshalFileMetaData - encodes file metadata in thrift compact protocol as stored in file footer.
+func MarshalFileMetaData(fileMeta *parquet.FileMetaData) ([]byte, error) {
+	return serializeThrift(fileMeta)
+}
+
+// UnmarshalFileMetaData - decodes file metadata encoded by MarshalFileMetaData.
+func UnmarshalFileMetaData(data []byte) (*parquet.FileMetaData, error) {
+	fileMeta := parquet.NewFileMetaData()
+	if _, err := readThriftStruct(data, fileMeta); err != nil {
+		return nil, err
+	}
+
+	return fileMeta, nil
+}
+
+// FooterCache - is in-process LRU cache of file metadata keyed by caller supplied identity of the file, for
+// example object name with its ETag. Metadata of encrypted file cached by reader is used by readers with the same
+// FileDecryptionProperties only. FooterCache is safe for concurrent use.
+type FooterCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  *list.List // Entries in most recently used first order.
+	elements map[string]*list.Element
+}
+
+type footerCacheEntry struct {
+	key        string
+	fileMeta   *parquet.FileMetaData
+	decryption *FileDecryptionProperties // Decryption properties the metadata is read with.
+}
+
+// NewFooterCache - creates footer cache holding up to capacity file metadata.
+func NewFooterCache(capacity int) *FooterCache {
+	return &FooterCache{
+		capacity: capacity,
+		entries:  list.New(),
+		elements: make(map[string]*list.Element),
+	}
+}
+
+// Get - returns cached file metadata of key.
+func (cache *FooterCache) Get(key string) (*parquet.FileMetaData, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	element, found := cache.elements[key]
+	if !found {
+		return nil, false
+	}
+
+	cache.entries.MoveToFront(element)
+	return element.Value.(*footerCacheEntry).fileMeta, true
+}
+
+// getDecrypted returns cached file metadata of key. Metadata of encrypted file is returned only if it is
+// read with decryption, hence it is not disclosed to readers without its keys.
+func (cache *FooterCache) getDecrypted(key string, decryption *FileDecryptionProperties) (*parquet.FileMetaData, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	element, found := cache.elements[key]
+	if !found {
+		return nil, false
+	}
+
+	entry := element.Value.(*footerCacheEntry)
+	if entry.fileMeta.IsSetEncryptionAlgorithm() && entry.decryption != decryption {
+		return nil, false
+	}
+
+	cache.entries.MoveToFront(element)
+	return entry.fileMeta, true
+}
+
+// Add - adds file metadata of key. Least recently used file metadata is evicted if the cache is full.
+func (cache *FooterCache) Add(key string, fileMeta *parquet.FileMetaData) {
+	cache.add(key, fileMeta, nil)
+}
+
+// add adds file metadata of key read with decryption.
+func (cache *FooterCache) add(key string, fileMeta *parquet.FileMetaData, decryption *FileDecryptionProperties) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if element, found := cache.elements[key]; found {
+		entry := element.Value.(*footerCacheEntry)
+		entry.fileMeta = fileMeta
+		entry.decryption = decryption
+		cache.entries.MoveToFront(element)
+		return
+	}
+
+	if cache.capacity <= 0 {
+		return
+	}
+
+	for cache.entries.Len() >= cache.capacity {
+		element := cache.entries.Back()
+		cache.entries.Remove(element)
+		delete(cache.elements, element.Value.(*footerCacheEntry).key)
+	}
+
+	cache.elements[key] = cache.entries.PushFront(&footerCacheEntry{key: key, fileMeta: fileMeta, decryption: decryption})
+}
+
+// Remove - removes file metadata of key.
+func (cache *FooterCache) Remove(key string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if element, found := cache.elements[key]; found {
+		cache.entries.Remove(element)
+		delete(cache.elements, key)
+	}
+}
+
+// Len - returns number of cached file metadata.
+func (cache *FooterCache) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.entries.Len()
+}
+
+// NewCachedReader - creates new parquet reader like NewReader using file metadata of key from cache. On cache
+// miss, footer is read and its metadata is added to cache. Key must change whenever the file changes.
+func NewCachedReader(getReaderFunc GetReaderFunc, columnNames set.StringSet, cache *FooterCache, key string) (*Reader, error) {
+	return NewReaderWithOptions(withContext(getReaderFunc), ReaderOptions{Columns: columnNames, FooterCache: cache, CacheKey: key})
+}
@@ -0,0 +1,259 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+)
+
+// readColumnA returns values of column a of all rows.
+func readColumnA(t *testing.T, reader *Reader) string {
+	var result []interface{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		value, _ := record.Get("a")
+		result = append(result, value.Value)
+	}
+
+	return fmt.Sprint(result)
+}
+
+func TestNewReaderWithMetadata(t *testing.T) {
+	data := writePageErrorFile(t)
+
+	reader, err := NewReader(bytesGetReaderFunc(data), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	footer, err := MarshalFileMetaData(reader.FileMetaData())
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader.Close()
+
+	fileMeta, err := UnmarshalFileMetaData(footer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var requests int
+	getReaderFunc := func(offset, length int64) (io.ReadCloser, error) {
+		if offset < 0 {
+			t.Fatalf("unexpected footer request")
+		}
+		requests++
+		return bytesGetReaderFunc(data)(offset, length)
+	}
+
+	if reader, err = NewReaderWithMetadata(getReaderFunc, fileMeta, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if result := readColumnA(t, reader); result != "[1 2 3 4]" {
+		t.Fatalf("expected: [1 2 3 4], got: %v", result)
+	}
+
+	if requests != 2 {
+		t.Fatalf("requests: expected: 2, got: %v", requests)
+	}
+
+	if _, err = NewReaderWithMetadata(getReaderFunc, nil, nil); err == nil {
+		t.Fatalf("err: expected: <error>, got: <nil>")
+	}
+
+	if _, err = UnmarshalFileMetaData(footer[:len(footer)/2]); err == nil {
+		t.Fatalf("err: expected: <error>, got: <nil>")
+	}
+}
+
+func TestFooterCache(t *testing.T) {
+	data := writePageErrorFile(t)
+
+	var footerRequests int
+	getReaderFunc := func(offset, length int64) (io.ReadCloser, error) {
+		if offset < 0 {
+			footerRequests++
+		}
+		return bytesGetReaderFunc(data)(offset, length)
+	}
+
+	cache := NewFooterCache(2)
+	testCases := []struct {
+		key                    string
+		expectedFooterRequests int
+	}{
+		{"a", 1},
+		{"a", 1},
+		{"b", 2},
+		{"a", 2},
+		{"c", 3}, // Evicts b.
+		{"a", 3},
+		{"b", 4},
+	}
+
+	for i, testCase := range testCases {
+		reader, err := NewCachedReader(getReaderFunc, nil, cache, testCase.key)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if result := readColumnA(t, reader); result != "[1 2 3 4]" {
+			t.Fatalf("case %v: expected: [1 2 3 4], got: %v", i+1, result)
+		}
+		reader.Close()
+
+		if footerRequests != testCase.expectedFooterRequests {
+			t.Fatalf("case %v: footer requests: expected: %v, got: %v", i+1, testCase.expectedFooterRequests, footerRequests)
+		}
+	}
+
+	if cache.Len() != 2 {
+		t.Fatalf("expected: 2, got: %v", cache.Len())
+	}
+
+	cache.Remove("a")
+	if _, found := cache.Get("a"); found {
+		t.Fatalf("expected: <not found>, got: <found>")
+	}
+}
+
+func TestNewReaderWithOptions(t *testing.T) {
+	os.Setenv("PARQUET_GO_TEST_FOOTER_KEY", hex.EncodeToString(testFooterKey))
+	os.Setenv("PARQUET_GO_TEST_COLUMN_KEY", hex.EncodeToString(testColumnKey))
+	defer os.Unsetenv("PARQUET_GO_TEST_FOOTER_KEY")
+	defer os.Unsetenv("PARQUET_GO_TEST_COLUMN_KEY")
+
+	decryption := &FileDecryptionProperties{KeyRetriever: envKeyRetriever}
+	for i, plaintextFooter := range []bool{false, true} {
+		data := writeEncryptedFile(t, &FileEncryptionProperties{
+			Algorithm:         AESGCMV1,
+			FooterKey:         testFooterKey,
+			FooterKeyMetadata: []byte("PARQUET_GO_TEST_FOOTER_KEY"),
+			ColumnKeys: map[string]ColumnEncryptionKey{
+				"name": {Key: testColumnKey, KeyMetadata: []byte("PARQUET_GO_TEST_COLUMN_KEY")},
+			},
+			PlaintextFooter: plaintextFooter,
+		})
+
+		var footerRequests int
+		getReaderFunc := func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+			if offset < 0 {
+				footerRequests++
+			}
+			return bytesGetReaderFunc(data)(offset, length)
+		}
+
+		cache := NewFooterCache(1)
+		reader, err := NewReaderWithOptions(getReaderFunc, ReaderOptions{Decryption: decryption, FooterCache: cache, CacheKey: "a"})
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		splits, err := reader.Splits(1)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+		reader.Close()
+
+		if footerRequests != 1 || cache.Len() != 1 {
+			t.Fatalf("case %v: footer requests: expected: 1, got: %v", i+1, footerRequests)
+		}
+
+		// Cached metadata is decrypted, checked against limits and read for the split.
+		opts := ReaderOptions{
+			Columns:     set.CreateStringSet("name"),
+			Decryption:  decryption,
+			Limits:      DefaultLimits(),
+			FooterCache: cache,
+			CacheKey:    "a",
+			Split:       &splits[1],
+			Context:     context.Background(),
+		}
+		if reader, err = NewReaderWithOptions(getReaderFunc, opts); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		var names []interface{}
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("case %v: %v", i+1, err)
+			}
+
+			value, _ := record.Get("name")
+			names = append(names, string(value.Value.([]byte)))
+		}
+		reader.Close()
+
+		if len(names) != 10 || names[0] != "name-10" {
+			t.Fatalf("case %v: expected: 10 names from name-10, got: %v", i+1, names)
+		}
+
+		if footerRequests != 1 {
+			t.Fatalf("case %v: footer requests: expected: 1, got: %v", i+1, footerRequests)
+		}
+
+		opts.Limits = &Limits{MaxRowGroups: 1}
+		if _, err = NewReaderWithOptions(getReaderFunc, opts); !errors.Is(err, ErrLimitExceeded) {
+			t.Fatalf("case %v: err: expected: %v, got: %v", i+1, ErrLimitExceeded, err)
+		}
+
+		// Without decryption properties, cached metadata is not used; encrypted footer is not readable and
+		// the column encrypted with its own key is neither readable nor are its statistics disclosed.
+		opts.Limits = nil
+		opts.Decryption = nil
+		reader, err = NewReaderWithOptions(getReaderFunc, opts)
+		if !plaintextFooter {
+			if err == nil {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if footerRequests != 2 {
+			t.Fatalf("case %v: footer requests: expected: 2, got: %v", i+1, footerRequests)
+		}
+		if meta, err := reader.columnMetaData(0, "name"); err != nil || meta.Statistics != nil {
+			t.Fatalf("case %v: expected: metadata without statistics, got: %v, %v", i+1, meta, err)
+		}
+		if _, err = reader.Read(); err == nil {
+			t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+		}
+		reader.Close()
+	}
+}
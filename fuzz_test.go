@@ -0,0 +1,119 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// fuzzColumnChunk returns column chunk data, metadata and schema elements of first row group of
+// writePageErrorFile for seed corpus.
+func fuzzColumnChunk(f *testing.F) ([]byte, *parquet.ColumnMetaData, []*parquet.SchemaElement) {
+	data := writePageErrorFile(f)
+
+	reader, err := NewReader(bytesGetReaderFunc(data), nil)
+	if err != nil {
+		f.Fatal(err)
+	}
+	defer reader.Close()
+
+	meta := reader.rowGroups[0].Columns[0].MetaData
+	offset := meta.GetDataPageOffset()
+	return data[offset : offset+meta.GetTotalCompressedSize()], meta, reader.schemaElements
+}
+
+func FuzzReadPageHeader(f *testing.F) {
+	chunk, _, _ := fuzzColumnChunk(f)
+	f.Add(chunk)
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		readPageHeader(context.Background(), thrift.NewStreamTransportR(bytes.NewReader(data)))
+	})
+}
+
+func FuzzReadPage(f *testing.F) {
+	chunk, meta, schemaElements := fuzzColumnChunk(f)
+	f.Add(chunk)
+
+	nameIndexMap := make(map[string]int)
+	for index, path := range schemaPaths(schemaElements) {
+		nameIndexMap[path] = index + 1
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		reader := thrift.NewStreamTransportR(bytes.NewReader(data))
+		page, _, _, err := readPage(context.Background(), reader, meta, nameIndexMap, schemaElements, true, nil, DefaultLimits(), false)
+		if err == nil && page.Header.GetType() != parquet.PageType_DICTIONARY_PAGE {
+			page.decode(nil)
+		}
+	})
+}
+
+func FuzzReadDataPageValues(f *testing.F) {
+	f.Add([]byte{1, 0, 0, 0, 2, 0, 0, 0}, int(parquet.Encoding_PLAIN), int(parquet.Type_INT32), uint16(2), uint8(0))
+	f.Add([]byte{3, 0, 0, 0, 'a', 'b', 'c'}, int(parquet.Encoding_PLAIN), int(parquet.Type_BYTE_ARRAY), uint16(1), uint8(0))
+	f.Add([]byte{2, 0, 0, 0, 3, 0xff}, int(parquet.Encoding_RLE), int(parquet.Type_INT64), uint16(8), uint8(1))
+	f.Add([]byte{1, 8, 1}, int(parquet.Encoding_RLE_DICTIONARY), int(parquet.Type_INT64), uint16(4), uint8(0))
+	f.Add([]byte{128, 1, 4, 3, 2, 0, 0, 0, 0, 0}, int(parquet.Encoding_DELTA_BINARY_PACKED), int(parquet.Type_INT64), uint16(3), uint8(0))
+	f.Add([]byte{1, 2, 3, 4, 5, 6, 7, 8}, int(parquet.Encoding_BYTE_STREAM_SPLIT), int(parquet.Type_FLOAT), uint16(2), uint8(0))
+
+	f.Fuzz(func(t *testing.T, data []byte, encoding, dataType int, count uint16, bitWidth uint8) {
+		readDataPageValues(bytes.NewReader(data), parquet.Encoding(encoding), parquet.Type(dataType),
+			-1, uint64(count), uint64(bitWidth))
+	})
+}
+
+func FuzzReader(f *testing.F) {
+	f.Add(writePageErrorFile(f))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		getReaderFunc := func(offset, length int64) (io.ReadCloser, error) {
+			if offset < 0 {
+				offset += int64(len(data))
+			}
+			if offset < 0 || offset > int64(len(data)) {
+				return nil, errors.New("offset out of range")
+			}
+
+			return ioutil.NopCloser(bytes.NewReader(data[offset:])), nil
+		}
+
+		reader, err := NewLimitedReader(getReaderFunc, nil, nil, DefaultLimits())
+		if err != nil {
+			return
+		}
+		defer reader.Close()
+
+		for {
+			if _, err = reader.Read(); err != nil {
+				break
+			}
+		}
+	})
+}
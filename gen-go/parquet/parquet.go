@@ -350,6 +350,7 @@ const (
 	Encoding_DELTA_LENGTH_BYTE_ARRAY Encoding = 6
 	Encoding_DELTA_BYTE_ARRAY        Encoding = 7
 	Encoding_RLE_DICTIONARY          Encoding = 8
+	Encoding_BYTE_STREAM_SPLIT       Encoding = 9
 )
 
 func (p Encoding) String() string {
@@ -370,6 +371,8 @@ func (p Encoding) String() string {
 		return "DELTA_BYTE_ARRAY"
 	case Encoding_RLE_DICTIONARY:
 		return "RLE_DICTIONARY"
+	case Encoding_BYTE_STREAM_SPLIT:
+		return "BYTE_STREAM_SPLIT"
 	}
 	return "<UNSET>"
 }
@@ -392,6 +395,8 @@ func EncodingFromString(s string) (Encoding, error) {
 		return Encoding_DELTA_BYTE_ARRAY, nil
 	case "RLE_DICTIONARY":
 		return Encoding_RLE_DICTIONARY, nil
+	case "BYTE_STREAM_SPLIT":
+		return Encoding_BYTE_STREAM_SPLIT, nil
 	}
 	return Encoding(0), fmt.Errorf("not a valid Encoding string")
 }
@@ -443,6 +448,7 @@ const (
 	CompressionCodec_BROTLI       CompressionCodec = 4
 	CompressionCodec_LZ4          CompressionCodec = 5
 	CompressionCodec_ZSTD         CompressionCodec = 6
+	CompressionCodec_LZ4_RAW      CompressionCodec = 7
 )
 
 func (p CompressionCodec) String() string {
@@ -461,6 +467,8 @@ func (p CompressionCodec) String() string {
 		return "LZ4"
 	case CompressionCodec_ZSTD:
 		return "ZSTD"
+	case CompressionCodec_LZ4_RAW:
+		return "LZ4_RAW"
 	}
 	return "<UNSET>"
 }
@@ -481,6 +489,8 @@ func CompressionCodecFromString(s string) (CompressionCodec, error) {
 		return CompressionCodec_LZ4, nil
 	case "ZSTD":
 		return CompressionCodec_ZSTD, nil
+	case "LZ4_RAW":
+		return CompressionCodec_LZ4_RAW, nil
 	}
 	return CompressionCodec(0), fmt.Errorf("not a valid CompressionCodec string")
 }
@@ -5383,117 +5393,110 @@ func (p *DataPageHeaderV2) String() string {
 	return fmt.Sprintf("DataPageHeaderV2(%+v)", *p)
 }
 
-// Attributes:
-//  - Type: the type of the page: indicates which of the *_header fields is set *
-//  - UncompressedPageSize: Uncompressed page size in bytes (not including this header) *
-//  - CompressedPageSize: Compressed page size in bytes (not including this header) *
-//  - Crc: 32bit crc for the data below. This allows for disabling checksumming in HDFS
-// if only a few pages needs to be read
-//
-//  - DataPageHeader
-//  - IndexPageHeader
-//  - DictionaryPageHeader
-//  - DataPageHeaderV2
-type PageHeader struct {
-	Type                 PageType              `thrift:"type,1,required" db:"type" json:"type"`
-	UncompressedPageSize int32                 `thrift:"uncompressed_page_size,2,required" db:"uncompressed_page_size" json:"uncompressed_page_size"`
-	CompressedPageSize   int32                 `thrift:"compressed_page_size,3,required" db:"compressed_page_size" json:"compressed_page_size"`
-	Crc                  *int32                `thrift:"crc,4" db:"crc" json:"crc,omitempty"`
-	DataPageHeader       *DataPageHeader       `thrift:"data_page_header,5" db:"data_page_header" json:"data_page_header,omitempty"`
-	IndexPageHeader      *IndexPageHeader      `thrift:"index_page_header,6" db:"index_page_header" json:"index_page_header,omitempty"`
-	DictionaryPageHeader *DictionaryPageHeader `thrift:"dictionary_page_header,7" db:"dictionary_page_header" json:"dictionary_page_header,omitempty"`
-	DataPageHeaderV2     *DataPageHeaderV2     `thrift:"data_page_header_v2,8" db:"data_page_header_v2" json:"data_page_header_v2,omitempty"`
-}
-
-func NewPageHeader() *PageHeader {
-	return &PageHeader{}
-}
-
-func (p *PageHeader) GetType() PageType {
-	return p.Type
-}
-
-func (p *PageHeader) GetUncompressedPageSize() int32 {
-	return p.UncompressedPageSize
+// Block-based algorithm type annotation. *
+type SplitBlockAlgorithm struct {
 }
 
-func (p *PageHeader) GetCompressedPageSize() int32 {
-	return p.CompressedPageSize
+func NewSplitBlockAlgorithm() *SplitBlockAlgorithm {
+	return &SplitBlockAlgorithm{}
 }
 
-var PageHeader_Crc_DEFAULT int32
+func (p *SplitBlockAlgorithm) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-func (p *PageHeader) GetCrc() int32 {
-	if !p.IsSetCrc() {
-		return PageHeader_Crc_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
-	return *p.Crc
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-var PageHeader_DataPageHeader_DEFAULT *DataPageHeader
-
-func (p *PageHeader) GetDataPageHeader() *DataPageHeader {
-	if !p.IsSetDataPageHeader() {
-		return PageHeader_DataPageHeader_DEFAULT
+func (p *SplitBlockAlgorithm) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SplitBlockAlgorithm"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return p.DataPageHeader
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var PageHeader_IndexPageHeader_DEFAULT *IndexPageHeader
-
-func (p *PageHeader) GetIndexPageHeader() *IndexPageHeader {
-	if !p.IsSetIndexPageHeader() {
-		return PageHeader_IndexPageHeader_DEFAULT
+func (p *SplitBlockAlgorithm) Equals(other *SplitBlockAlgorithm) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return p.IndexPageHeader
+	return true
 }
 
-var PageHeader_DictionaryPageHeader_DEFAULT *DictionaryPageHeader
-
-func (p *PageHeader) GetDictionaryPageHeader() *DictionaryPageHeader {
-	if !p.IsSetDictionaryPageHeader() {
-		return PageHeader_DictionaryPageHeader_DEFAULT
+func (p *SplitBlockAlgorithm) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.DictionaryPageHeader
+	return fmt.Sprintf("SplitBlockAlgorithm(%+v)", *p)
 }
 
-var PageHeader_DataPageHeaderV2_DEFAULT *DataPageHeaderV2
 
-func (p *PageHeader) GetDataPageHeaderV2() *DataPageHeaderV2 {
-	if !p.IsSetDataPageHeaderV2() {
-		return PageHeader_DataPageHeaderV2_DEFAULT
-	}
-	return p.DataPageHeaderV2
-}
-func (p *PageHeader) IsSetCrc() bool {
-	return p.Crc != nil
+// The algorithm used in Bloom filter. *
+//
+// Attributes:
+//  - BLOCK: Block-based Bloom filter. *
+type BloomFilterAlgorithm struct {
+	BLOCK *SplitBlockAlgorithm `thrift:"BLOCK,1" db:"BLOCK" json:"BLOCK,omitempty"`
 }
 
-func (p *PageHeader) IsSetDataPageHeader() bool {
-	return p.DataPageHeader != nil
+func NewBloomFilterAlgorithm() *BloomFilterAlgorithm {
+	return &BloomFilterAlgorithm{}
 }
 
-func (p *PageHeader) IsSetIndexPageHeader() bool {
-	return p.IndexPageHeader != nil
+var BloomFilterAlgorithm_BLOCK_DEFAULT *SplitBlockAlgorithm
+
+func (p *BloomFilterAlgorithm) GetBLOCK() *SplitBlockAlgorithm {
+	if !p.IsSetBLOCK() {
+		return BloomFilterAlgorithm_BLOCK_DEFAULT
+	}
+	return p.BLOCK
 }
+func (p *BloomFilterAlgorithm) CountSetFieldsBloomFilterAlgorithm() int {
+	count := 0
+	if p.IsSetBLOCK() {
+		count++
+	}
+	return count
 
-func (p *PageHeader) IsSetDictionaryPageHeader() bool {
-	return p.DictionaryPageHeader != nil
 }
 
-func (p *PageHeader) IsSetDataPageHeaderV2() bool {
-	return p.DataPageHeaderV2 != nil
+func (p *BloomFilterAlgorithm) IsSetBLOCK() bool {
+	return p.BLOCK != nil
 }
 
-func (p *PageHeader) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *BloomFilterAlgorithm) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetType bool = false
-	var issetUncompressedPageSize bool = false
-	var issetCompressedPageSize bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
 		if err != nil {
@@ -5504,81 +5507,8 @@ func (p *PageHeader) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField1(ctx, iprot); err != nil {
-					return err
-				}
-				issetType = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
-				issetUncompressedPageSize = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-				issetCompressedPageSize = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField7(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 8:
 			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField8(ctx, iprot); err != nil {
+				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -5598,119 +5528,115 @@ func (p *PageHeader) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetType {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Type is not set"))
-	}
-	if !issetUncompressedPageSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field UncompressedPageSize is not set"))
-	}
-	if !issetCompressedPageSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
-	}
 	return nil
 }
 
-func (p *PageHeader) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		temp := PageType(v)
-		p.Type = temp
+func (p *BloomFilterAlgorithm) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.BLOCK = &SplitBlockAlgorithm{}
+	if err := p.BLOCK.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BLOCK), err)
 	}
 	return nil
 }
 
-func (p *PageHeader) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.UncompressedPageSize = v
+func (p *BloomFilterAlgorithm) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsBloomFilterAlgorithm(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set)", p, c)
 	}
-	return nil
-}
-
-func (p *PageHeader) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.CompressedPageSize = v
+	if err := oprot.WriteStructBegin(ctx, "BloomFilterAlgorithm"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *PageHeader) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.Crc = &v
+func (p *BloomFilterAlgorithm) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBLOCK() {
+		if err := oprot.WriteFieldBegin(ctx, "BLOCK", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:BLOCK: ", p), err)
+		}
+		if err := p.BLOCK.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BLOCK), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:BLOCK: ", p), err)
+		}
 	}
-	return nil
+	return err
 }
 
-func (p *PageHeader) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	p.DataPageHeader = &DataPageHeader{}
-	if err := p.DataPageHeader.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DataPageHeader), err)
+func (p *BloomFilterAlgorithm) Equals(other *BloomFilterAlgorithm) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return nil
+	if !p.BLOCK.Equals(other.BLOCK) {
+		return false
+	}
+	return true
 }
 
-func (p *PageHeader) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	p.IndexPageHeader = &IndexPageHeader{}
-	if err := p.IndexPageHeader.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IndexPageHeader), err)
+func (p *BloomFilterAlgorithm) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
+	return fmt.Sprintf("BloomFilterAlgorithm(%+v)", *p)
 }
 
-func (p *PageHeader) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
-	p.DictionaryPageHeader = &DictionaryPageHeader{}
-	if err := p.DictionaryPageHeader.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DictionaryPageHeader), err)
-	}
-	return nil
+
+// Hash strategy type annotation. xxHash is an extremely fast non-cryptographic hash
+// algorithm. It uses 64 bits version of xxHash.
+// *
+type XxHash struct {
 }
 
-func (p *PageHeader) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
-	p.DataPageHeaderV2 = &DataPageHeaderV2{
-		IsCompressed: true,
-	}
-	if err := p.DataPageHeaderV2.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DataPageHeaderV2), err)
-	}
-	return nil
+func NewXxHash() *XxHash {
+	return &XxHash{}
 }
 
-func (p *PageHeader) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "PageHeader"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+func (p *XxHash) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	if p != nil {
-		if err := p.writeField1(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := p.writeField6(ctx, oprot); err != nil {
-			return err
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := p.writeField7(ctx, oprot); err != nil {
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 			return err
 		}
-		if err := p.writeField8(ctx, oprot); err != nil {
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
 			return err
 		}
 	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *XxHash) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "XxHash"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -5720,202 +5646,264 @@ func (p *PageHeader) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PageHeader) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "type", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
-	}
-	if err := oprot.WriteI32(ctx, int32(p.Type)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
+func (p *XxHash) Equals(other *XxHash) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return err
+	return true
 }
 
-func (p *PageHeader) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "uncompressed_page_size", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:uncompressed_page_size: ", p), err)
-	}
-	if err := oprot.WriteI32(ctx, int32(p.UncompressedPageSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.uncompressed_page_size (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:uncompressed_page_size: ", p), err)
+func (p *XxHash) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return err
+	return fmt.Sprintf("XxHash(%+v)", *p)
 }
 
-func (p *PageHeader) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "compressed_page_size", thrift.I32, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:compressed_page_size: ", p), err)
-	}
-	if err := oprot.WriteI32(ctx, int32(p.CompressedPageSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (3) field write error: ", p), err)
+
+// The hash function used in Bloom filter. This function takes the hash of a column value
+// using plain encoding.
+// *
+//
+// Attributes:
+//  - XXHASH: xxHash Strategy. *
+type BloomFilterHash struct {
+	XXHASH *XxHash `thrift:"XXHASH,1" db:"XXHASH" json:"XXHASH,omitempty"`
+}
+
+func NewBloomFilterHash() *BloomFilterHash {
+	return &BloomFilterHash{}
+}
+
+var BloomFilterHash_XXHASH_DEFAULT *XxHash
+
+func (p *BloomFilterHash) GetXXHASH() *XxHash {
+	if !p.IsSetXXHASH() {
+		return BloomFilterHash_XXHASH_DEFAULT
 	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:compressed_page_size: ", p), err)
+	return p.XXHASH
+}
+func (p *BloomFilterHash) CountSetFieldsBloomFilterHash() int {
+	count := 0
+	if p.IsSetXXHASH() {
+		count++
 	}
-	return err
+	return count
+
 }
 
-func (p *PageHeader) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetCrc() {
-		if err := oprot.WriteFieldBegin(ctx, "crc", thrift.I32, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:crc: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.Crc)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.crc (4) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:crc: ", p), err)
-		}
-	}
-	return err
+func (p *BloomFilterHash) IsSetXXHASH() bool {
+	return p.XXHASH != nil
 }
 
-func (p *PageHeader) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetDataPageHeader() {
-		if err := oprot.WriteFieldBegin(ctx, "data_page_header", thrift.STRUCT, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:data_page_header: ", p), err)
+func (p *BloomFilterHash) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := p.DataPageHeader.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DataPageHeader), err)
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:data_page_header: ", p), err)
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *PageHeader) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetIndexPageHeader() {
-		if err := oprot.WriteFieldBegin(ctx, "index_page_header", thrift.STRUCT, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:index_page_header: ", p), err)
-		}
-		if err := p.IndexPageHeader.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IndexPageHeader), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:index_page_header: ", p), err)
-		}
+func (p *BloomFilterHash) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.XXHASH = &XxHash{}
+	if err := p.XXHASH.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.XXHASH), err)
 	}
-	return err
+	return nil
 }
 
-func (p *PageHeader) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetDictionaryPageHeader() {
-		if err := oprot.WriteFieldBegin(ctx, "dictionary_page_header", thrift.STRUCT, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:dictionary_page_header: ", p), err)
-		}
-		if err := p.DictionaryPageHeader.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DictionaryPageHeader), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:dictionary_page_header: ", p), err)
+func (p *BloomFilterHash) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsBloomFilterHash(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set)", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "BloomFilterHash"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *PageHeader) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetDataPageHeaderV2() {
-		if err := oprot.WriteFieldBegin(ctx, "data_page_header_v2", thrift.STRUCT, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:data_page_header_v2: ", p), err)
+func (p *BloomFilterHash) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetXXHASH() {
+		if err := oprot.WriteFieldBegin(ctx, "XXHASH", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:XXHASH: ", p), err)
 		}
-		if err := p.DataPageHeaderV2.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DataPageHeaderV2), err)
+		if err := p.XXHASH.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.XXHASH), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:data_page_header_v2: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:XXHASH: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *PageHeader) Equals(other *PageHeader) bool {
+func (p *BloomFilterHash) Equals(other *BloomFilterHash) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.Type != other.Type {
-		return false
-	}
-	if p.UncompressedPageSize != other.UncompressedPageSize {
-		return false
-	}
-	if p.CompressedPageSize != other.CompressedPageSize {
-		return false
-	}
-	if p.Crc != other.Crc {
-		if p.Crc == nil || other.Crc == nil {
-			return false
-		}
-		if (*p.Crc) != (*other.Crc) {
-			return false
-		}
-	}
-	if !p.DataPageHeader.Equals(other.DataPageHeader) {
-		return false
-	}
-	if !p.IndexPageHeader.Equals(other.IndexPageHeader) {
-		return false
-	}
-	if !p.DictionaryPageHeader.Equals(other.DictionaryPageHeader) {
-		return false
-	}
-	if !p.DataPageHeaderV2.Equals(other.DataPageHeaderV2) {
+	if !p.XXHASH.Equals(other.XXHASH) {
 		return false
 	}
 	return true
 }
 
-func (p *PageHeader) String() string {
+func (p *BloomFilterHash) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PageHeader(%+v)", *p)
+	return fmt.Sprintf("BloomFilterHash(%+v)", *p)
 }
 
-// Wrapper struct to store key values
-//
-// Attributes:
-//  - Key
-//  - Value
-type KeyValue struct {
-	Key   string  `thrift:"key,1,required" db:"key" json:"key"`
-	Value *string `thrift:"value,2" db:"value" json:"value,omitempty"`
+
+// The compression used in the Bloom filter.
+// *
+type Uncompressed struct {
 }
 
-func NewKeyValue() *KeyValue {
-	return &KeyValue{}
+func NewUncompressed() *Uncompressed {
+	return &Uncompressed{}
 }
 
-func (p *KeyValue) GetKey() string {
-	return p.Key
+func (p *Uncompressed) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-var KeyValue_Value_DEFAULT string
+func (p *Uncompressed) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Uncompressed"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
 
-func (p *KeyValue) GetValue() string {
-	if !p.IsSetValue() {
-		return KeyValue_Value_DEFAULT
+func (p *Uncompressed) Equals(other *Uncompressed) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return *p.Value
+	return true
 }
-func (p *KeyValue) IsSetValue() bool {
-	return p.Value != nil
+
+func (p *Uncompressed) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Uncompressed(%+v)", *p)
 }
 
-func (p *KeyValue) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+// Attributes:
+//  - UNCOMPRESSED
+type BloomFilterCompression struct {
+	UNCOMPRESSED *Uncompressed `thrift:"UNCOMPRESSED,1" db:"UNCOMPRESSED" json:"UNCOMPRESSED,omitempty"`
+}
+
+func NewBloomFilterCompression() *BloomFilterCompression {
+	return &BloomFilterCompression{}
+}
+
+var BloomFilterCompression_UNCOMPRESSED_DEFAULT *Uncompressed
+
+func (p *BloomFilterCompression) GetUNCOMPRESSED() *Uncompressed {
+	if !p.IsSetUNCOMPRESSED() {
+		return BloomFilterCompression_UNCOMPRESSED_DEFAULT
+	}
+	return p.UNCOMPRESSED
+}
+func (p *BloomFilterCompression) CountSetFieldsBloomFilterCompression() int {
+	count := 0
+	if p.IsSetUNCOMPRESSED() {
+		count++
+	}
+	return count
+
+}
+
+func (p *BloomFilterCompression) IsSetUNCOMPRESSED() bool {
+	return p.UNCOMPRESSED != nil
+}
+
+func (p *BloomFilterCompression) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetKey bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
 		if err != nil {
@@ -5926,21 +5914,10 @@ func (p *KeyValue) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
-				issetKey = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -5958,41 +5935,28 @@ func (p *KeyValue) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetKey {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Key is not set"))
-	}
 	return nil
 }
 
-func (p *KeyValue) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Key = v
+func (p *BloomFilterCompression) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.UNCOMPRESSED = &Uncompressed{}
+	if err := p.UNCOMPRESSED.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UNCOMPRESSED), err)
 	}
 	return nil
 }
 
-func (p *KeyValue) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Value = &v
+func (p *BloomFilterCompression) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsBloomFilterCompression(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set)", p, c)
 	}
-	return nil
-}
-
-func (p *KeyValue) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "KeyValue"); err != nil {
+	if err := oprot.WriteStructBegin(ctx, "BloomFilterCompression"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6003,97 +5967,112 @@ func (p *KeyValue) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *KeyValue) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "key", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:key: ", p), err)
-	}
-	if err := oprot.WriteString(ctx, string(p.Key)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.key (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:key: ", p), err)
-	}
-	return err
-}
-
-func (p *KeyValue) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetValue() {
-		if err := oprot.WriteFieldBegin(ctx, "value", thrift.STRING, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:value: ", p), err)
+func (p *BloomFilterCompression) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetUNCOMPRESSED() {
+		if err := oprot.WriteFieldBegin(ctx, "UNCOMPRESSED", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:UNCOMPRESSED: ", p), err)
 		}
-		if err := oprot.WriteString(ctx, string(*p.Value)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.value (2) field write error: ", p), err)
+		if err := p.UNCOMPRESSED.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UNCOMPRESSED), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:value: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:UNCOMPRESSED: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *KeyValue) Equals(other *KeyValue) bool {
+func (p *BloomFilterCompression) Equals(other *BloomFilterCompression) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.Key != other.Key {
+	if !p.UNCOMPRESSED.Equals(other.UNCOMPRESSED) {
 		return false
 	}
-	if p.Value != other.Value {
-		if p.Value == nil || other.Value == nil {
-			return false
-		}
-		if (*p.Value) != (*other.Value) {
-			return false
-		}
-	}
 	return true
 }
 
-func (p *KeyValue) String() string {
+func (p *BloomFilterCompression) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("KeyValue(%+v)", *p)
+	return fmt.Sprintf("BloomFilterCompression(%+v)", *p)
 }
 
-// Wrapper struct to specify sort order
+
+// Bloom filter header is stored at beginning of Bloom filter data of each column
+// and followed by its bitset.
+// *
 //
 // Attributes:
-//  - ColumnIdx: The column index (in this row group) *
-//  - Descending: If true, indicates this column is sorted in descending order. *
-//  - NullsFirst: If true, nulls will come before non-null values, otherwise,
-// nulls go at the end.
-type SortingColumn struct {
-	ColumnIdx  int32 `thrift:"column_idx,1,required" db:"column_idx" json:"column_idx"`
-	Descending bool  `thrift:"descending,2,required" db:"descending" json:"descending"`
-	NullsFirst bool  `thrift:"nulls_first,3,required" db:"nulls_first" json:"nulls_first"`
+//  - NumBytes: The size of bitset in bytes *
+//  - Algorithm: The algorithm for setting bits. *
+//  - Hash: The hash function used for Bloom filter. *
+//  - Compression: The compression used in the Bloom filter *
+type BloomFilterHeader struct {
+	NumBytes    int32                   `thrift:"numBytes,1,required" db:"numBytes" json:"numBytes"`
+	Algorithm   *BloomFilterAlgorithm   `thrift:"algorithm,2,required" db:"algorithm" json:"algorithm"`
+	Hash        *BloomFilterHash        `thrift:"hash,3,required" db:"hash" json:"hash"`
+	Compression *BloomFilterCompression `thrift:"compression,4,required" db:"compression" json:"compression"`
 }
 
-func NewSortingColumn() *SortingColumn {
-	return &SortingColumn{}
+func NewBloomFilterHeader() *BloomFilterHeader {
+	return &BloomFilterHeader{}
 }
 
-func (p *SortingColumn) GetColumnIdx() int32 {
-	return p.ColumnIdx
+func (p *BloomFilterHeader) GetNumBytes() int32 {
+	return p.NumBytes
 }
 
-func (p *SortingColumn) GetDescending() bool {
-	return p.Descending
+var BloomFilterHeader_Algorithm_DEFAULT *BloomFilterAlgorithm
+
+func (p *BloomFilterHeader) GetAlgorithm() *BloomFilterAlgorithm {
+	if !p.IsSetAlgorithm() {
+		return BloomFilterHeader_Algorithm_DEFAULT
+	}
+	return p.Algorithm
 }
 
-func (p *SortingColumn) GetNullsFirst() bool {
-	return p.NullsFirst
+var BloomFilterHeader_Hash_DEFAULT *BloomFilterHash
+
+func (p *BloomFilterHeader) GetHash() *BloomFilterHash {
+	if !p.IsSetHash() {
+		return BloomFilterHeader_Hash_DEFAULT
+	}
+	return p.Hash
 }
-func (p *SortingColumn) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+var BloomFilterHeader_Compression_DEFAULT *BloomFilterCompression
+
+func (p *BloomFilterHeader) GetCompression() *BloomFilterCompression {
+	if !p.IsSetCompression() {
+		return BloomFilterHeader_Compression_DEFAULT
+	}
+	return p.Compression
+}
+func (p *BloomFilterHeader) IsSetAlgorithm() bool {
+	return p.Algorithm != nil
+}
+
+func (p *BloomFilterHeader) IsSetHash() bool {
+	return p.Hash != nil
+}
+
+func (p *BloomFilterHeader) IsSetCompression() bool {
+	return p.Compression != nil
+}
+
+func (p *BloomFilterHeader) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetColumnIdx bool = false
-	var issetDescending bool = false
-	var issetNullsFirst bool = false
+	var issetNumBytes bool = false
+	var issetAlgorithm bool = false
+	var issetHash bool = false
+	var issetCompression bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
@@ -6109,29 +6088,40 @@ func (p *SortingColumn) Read(ctx context.Context, iprot thrift.TProtocol) error
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
-				issetColumnIdx = true
+				issetNumBytes = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
-				issetDescending = true
+				issetAlgorithm = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
-				issetNullsFirst = true
+				issetHash = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+				issetCompression = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -6149,47 +6139,56 @@ func (p *SortingColumn) Read(ctx context.Context, iprot thrift.TProtocol) error
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetColumnIdx {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ColumnIdx is not set"))
+	if !issetNumBytes {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumBytes is not set"))
 	}
-	if !issetDescending {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Descending is not set"))
+	if !issetAlgorithm {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Algorithm is not set"))
 	}
-	if !issetNullsFirst {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullsFirst is not set"))
+	if !issetHash {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Hash is not set"))
+	}
+	if !issetCompression {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Compression is not set"))
 	}
 	return nil
 }
 
-func (p *SortingColumn) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *BloomFilterHeader) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ColumnIdx = v
+		p.NumBytes = v
 	}
 	return nil
 }
 
-func (p *SortingColumn) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Descending = v
+func (p *BloomFilterHeader) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Algorithm = &BloomFilterAlgorithm{}
+	if err := p.Algorithm.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Algorithm), err)
 	}
 	return nil
 }
 
-func (p *SortingColumn) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(ctx); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.NullsFirst = v
+func (p *BloomFilterHeader) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Hash = &BloomFilterHash{}
+	if err := p.Hash.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Hash), err)
 	}
 	return nil
 }
 
-func (p *SortingColumn) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "SortingColumn"); err != nil {
+func (p *BloomFilterHeader) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Compression = &BloomFilterCompression{}
+	if err := p.Compression.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Compression), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHeader) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "BloomFilterHeader"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -6202,6 +6201,9 @@ func (p *SortingColumn) Write(ctx context.Context, oprot thrift.TProtocol) error
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6212,105 +6214,196 @@ func (p *SortingColumn) Write(ctx context.Context, oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *SortingColumn) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "column_idx", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:column_idx: ", p), err)
+func (p *BloomFilterHeader) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "numBytes", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numBytes: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ColumnIdx)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.column_idx (1) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.NumBytes)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.numBytes (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:column_idx: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numBytes: ", p), err)
 	}
 	return err
 }
 
-func (p *SortingColumn) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "descending", thrift.BOOL, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:descending: ", p), err)
+func (p *BloomFilterHeader) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "algorithm", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:algorithm: ", p), err)
 	}
-	if err := oprot.WriteBool(ctx, bool(p.Descending)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.descending (2) field write error: ", p), err)
+	if err := p.Algorithm.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Algorithm), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:descending: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:algorithm: ", p), err)
 	}
 	return err
 }
 
-func (p *SortingColumn) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "nulls_first", thrift.BOOL, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:nulls_first: ", p), err)
+func (p *BloomFilterHeader) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "hash", thrift.STRUCT, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:hash: ", p), err)
 	}
-	if err := oprot.WriteBool(ctx, bool(p.NullsFirst)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.nulls_first (3) field write error: ", p), err)
+	if err := p.Hash.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Hash), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:nulls_first: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:hash: ", p), err)
 	}
 	return err
 }
 
-func (p *SortingColumn) Equals(other *SortingColumn) bool {
+func (p *BloomFilterHeader) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "compression", thrift.STRUCT, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:compression: ", p), err)
+	}
+	if err := p.Compression.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Compression), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:compression: ", p), err)
+	}
+	return err
+}
+
+func (p *BloomFilterHeader) Equals(other *BloomFilterHeader) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.ColumnIdx != other.ColumnIdx {
+	if p.NumBytes != other.NumBytes {
 		return false
 	}
-	if p.Descending != other.Descending {
+	if !p.Algorithm.Equals(other.Algorithm) {
 		return false
 	}
-	if p.NullsFirst != other.NullsFirst {
+	if !p.Hash.Equals(other.Hash) {
+		return false
+	}
+	if !p.Compression.Equals(other.Compression) {
 		return false
 	}
 	return true
 }
 
-func (p *SortingColumn) String() string {
+func (p *BloomFilterHeader) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SortingColumn(%+v)", *p)
+	return fmt.Sprintf("BloomFilterHeader(%+v)", *p)
 }
 
-// statistics of a given page type and encoding
-//
 // Attributes:
-//  - PageType: the page type (data/dic/...) *
-//  - Encoding: encoding of the page *
-//  - Count: number of pages of this type with this encoding *
-type PageEncodingStats struct {
-	PageType PageType `thrift:"page_type,1,required" db:"page_type" json:"page_type"`
-	Encoding Encoding `thrift:"encoding,2,required" db:"encoding" json:"encoding"`
-	Count    int32    `thrift:"count,3,required" db:"count" json:"count"`
+//  - Type: the type of the page: indicates which of the *_header fields is set *
+//  - UncompressedPageSize: Uncompressed page size in bytes (not including this header) *
+//  - CompressedPageSize: Compressed page size in bytes (not including this header) *
+//  - Crc: 32bit crc for the data below. This allows for disabling checksumming in HDFS
+// if only a few pages needs to be read
+//
+//  - DataPageHeader
+//  - IndexPageHeader
+//  - DictionaryPageHeader
+//  - DataPageHeaderV2
+type PageHeader struct {
+	Type                 PageType              `thrift:"type,1,required" db:"type" json:"type"`
+	UncompressedPageSize int32                 `thrift:"uncompressed_page_size,2,required" db:"uncompressed_page_size" json:"uncompressed_page_size"`
+	CompressedPageSize   int32                 `thrift:"compressed_page_size,3,required" db:"compressed_page_size" json:"compressed_page_size"`
+	Crc                  *int32                `thrift:"crc,4" db:"crc" json:"crc,omitempty"`
+	DataPageHeader       *DataPageHeader       `thrift:"data_page_header,5" db:"data_page_header" json:"data_page_header,omitempty"`
+	IndexPageHeader      *IndexPageHeader      `thrift:"index_page_header,6" db:"index_page_header" json:"index_page_header,omitempty"`
+	DictionaryPageHeader *DictionaryPageHeader `thrift:"dictionary_page_header,7" db:"dictionary_page_header" json:"dictionary_page_header,omitempty"`
+	DataPageHeaderV2     *DataPageHeaderV2     `thrift:"data_page_header_v2,8" db:"data_page_header_v2" json:"data_page_header_v2,omitempty"`
 }
 
-func NewPageEncodingStats() *PageEncodingStats {
-	return &PageEncodingStats{}
+func NewPageHeader() *PageHeader {
+	return &PageHeader{}
 }
 
-func (p *PageEncodingStats) GetPageType() PageType {
-	return p.PageType
+func (p *PageHeader) GetType() PageType {
+	return p.Type
 }
 
-func (p *PageEncodingStats) GetEncoding() Encoding {
-	return p.Encoding
+func (p *PageHeader) GetUncompressedPageSize() int32 {
+	return p.UncompressedPageSize
 }
 
-func (p *PageEncodingStats) GetCount() int32 {
-	return p.Count
+func (p *PageHeader) GetCompressedPageSize() int32 {
+	return p.CompressedPageSize
 }
-func (p *PageEncodingStats) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+var PageHeader_Crc_DEFAULT int32
+
+func (p *PageHeader) GetCrc() int32 {
+	if !p.IsSetCrc() {
+		return PageHeader_Crc_DEFAULT
+	}
+	return *p.Crc
+}
+
+var PageHeader_DataPageHeader_DEFAULT *DataPageHeader
+
+func (p *PageHeader) GetDataPageHeader() *DataPageHeader {
+	if !p.IsSetDataPageHeader() {
+		return PageHeader_DataPageHeader_DEFAULT
+	}
+	return p.DataPageHeader
+}
+
+var PageHeader_IndexPageHeader_DEFAULT *IndexPageHeader
+
+func (p *PageHeader) GetIndexPageHeader() *IndexPageHeader {
+	if !p.IsSetIndexPageHeader() {
+		return PageHeader_IndexPageHeader_DEFAULT
+	}
+	return p.IndexPageHeader
+}
+
+var PageHeader_DictionaryPageHeader_DEFAULT *DictionaryPageHeader
+
+func (p *PageHeader) GetDictionaryPageHeader() *DictionaryPageHeader {
+	if !p.IsSetDictionaryPageHeader() {
+		return PageHeader_DictionaryPageHeader_DEFAULT
+	}
+	return p.DictionaryPageHeader
+}
+
+var PageHeader_DataPageHeaderV2_DEFAULT *DataPageHeaderV2
+
+func (p *PageHeader) GetDataPageHeaderV2() *DataPageHeaderV2 {
+	if !p.IsSetDataPageHeaderV2() {
+		return PageHeader_DataPageHeaderV2_DEFAULT
+	}
+	return p.DataPageHeaderV2
+}
+func (p *PageHeader) IsSetCrc() bool {
+	return p.Crc != nil
+}
+
+func (p *PageHeader) IsSetDataPageHeader() bool {
+	return p.DataPageHeader != nil
+}
+
+func (p *PageHeader) IsSetIndexPageHeader() bool {
+	return p.IndexPageHeader != nil
+}
+
+func (p *PageHeader) IsSetDictionaryPageHeader() bool {
+	return p.DictionaryPageHeader != nil
+}
+
+func (p *PageHeader) IsSetDataPageHeaderV2() bool {
+	return p.DataPageHeaderV2 != nil
+}
+
+func (p *PageHeader) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetPageType bool = false
-	var issetEncoding bool = false
-	var issetCount bool = false
+	var issetType bool = false
+	var issetUncompressedPageSize bool = false
+	var issetCompressedPageSize bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
@@ -6326,7 +6419,7 @@ func (p *PageEncodingStats) Read(ctx context.Context, iprot thrift.TProtocol) er
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
-				issetPageType = true
+				issetType = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -6337,7 +6430,7 @@ func (p *PageEncodingStats) Read(ctx context.Context, iprot thrift.TProtocol) er
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
-				issetEncoding = true
+				issetUncompressedPageSize = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -6348,7 +6441,57 @@ func (p *PageEncodingStats) Read(ctx context.Context, iprot thrift.TProtocol) er
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
-				issetCount = true
+				issetCompressedPageSize = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -6366,49 +6509,91 @@ func (p *PageEncodingStats) Read(ctx context.Context, iprot thrift.TProtocol) er
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetPageType {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageType is not set"))
+	if !issetType {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Type is not set"))
 	}
-	if !issetEncoding {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
+	if !issetUncompressedPageSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field UncompressedPageSize is not set"))
 	}
-	if !issetCount {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Count is not set"))
+	if !issetCompressedPageSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *PageHeader) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
 		temp := PageType(v)
-		p.PageType = temp
+		p.Type = temp
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *PageHeader) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		temp := Encoding(v)
-		p.Encoding = temp
+		p.UncompressedPageSize = v
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *PageHeader) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.Count = v
+		p.CompressedPageSize = v
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "PageEncodingStats"); err != nil {
+func (p *PageHeader) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Crc = &v
+	}
+	return nil
+}
+
+func (p *PageHeader) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	p.DataPageHeader = &DataPageHeader{}
+	if err := p.DataPageHeader.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DataPageHeader), err)
+	}
+	return nil
+}
+
+func (p *PageHeader) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	p.IndexPageHeader = &IndexPageHeader{}
+	if err := p.IndexPageHeader.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IndexPageHeader), err)
+	}
+	return nil
+}
+
+func (p *PageHeader) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	p.DictionaryPageHeader = &DictionaryPageHeader{}
+	if err := p.DictionaryPageHeader.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DictionaryPageHeader), err)
+	}
+	return nil
+}
+
+func (p *PageHeader) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	p.DataPageHeaderV2 = &DataPageHeaderV2{
+		IsCompressed: true,
+	}
+	if err := p.DataPageHeaderV2.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DataPageHeaderV2), err)
+	}
+	return nil
+}
+
+func (p *PageHeader) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PageHeader"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -6421,222 +6606,405 @@ func (p *PageEncodingStats) Write(ctx context.Context, oprot thrift.TProtocol) e
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteFieldStop(ctx); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(ctx); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "page_type", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_type: ", p), err)
+func (p *PageHeader) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "type", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.PageType)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.page_type (1) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_type: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
 	}
 	return err
 }
 
-func (p *PageEncodingStats) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "encoding", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encoding: ", p), err)
+func (p *PageHeader) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "uncompressed_page_size", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:uncompressed_page_size: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.Encoding)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.encoding (2) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.UncompressedPageSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.uncompressed_page_size (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encoding: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:uncompressed_page_size: ", p), err)
 	}
 	return err
 }
 
-func (p *PageEncodingStats) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "count", thrift.I32, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:count: ", p), err)
+func (p *PageHeader) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "compressed_page_size", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:compressed_page_size: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.Count)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.count (3) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.CompressedPageSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:count: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:compressed_page_size: ", p), err)
 	}
 	return err
 }
 
-func (p *PageEncodingStats) Equals(other *PageEncodingStats) bool {
+func (p *PageHeader) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetCrc() {
+		if err := oprot.WriteFieldBegin(ctx, "crc", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:crc: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.Crc)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.crc (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:crc: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDataPageHeader() {
+		if err := oprot.WriteFieldBegin(ctx, "data_page_header", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:data_page_header: ", p), err)
+		}
+		if err := p.DataPageHeader.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DataPageHeader), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:data_page_header: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIndexPageHeader() {
+		if err := oprot.WriteFieldBegin(ctx, "index_page_header", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:index_page_header: ", p), err)
+		}
+		if err := p.IndexPageHeader.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IndexPageHeader), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:index_page_header: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDictionaryPageHeader() {
+		if err := oprot.WriteFieldBegin(ctx, "dictionary_page_header", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:dictionary_page_header: ", p), err)
+		}
+		if err := p.DictionaryPageHeader.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DictionaryPageHeader), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:dictionary_page_header: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDataPageHeaderV2() {
+		if err := oprot.WriteFieldBegin(ctx, "data_page_header_v2", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:data_page_header_v2: ", p), err)
+		}
+		if err := p.DataPageHeaderV2.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DataPageHeaderV2), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:data_page_header_v2: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) Equals(other *PageHeader) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.PageType != other.PageType {
+	if p.Type != other.Type {
 		return false
 	}
-	if p.Encoding != other.Encoding {
+	if p.UncompressedPageSize != other.UncompressedPageSize {
 		return false
 	}
-	if p.Count != other.Count {
+	if p.CompressedPageSize != other.CompressedPageSize {
+		return false
+	}
+	if p.Crc != other.Crc {
+		if p.Crc == nil || other.Crc == nil {
+			return false
+		}
+		if (*p.Crc) != (*other.Crc) {
+			return false
+		}
+	}
+	if !p.DataPageHeader.Equals(other.DataPageHeader) {
+		return false
+	}
+	if !p.IndexPageHeader.Equals(other.IndexPageHeader) {
+		return false
+	}
+	if !p.DictionaryPageHeader.Equals(other.DictionaryPageHeader) {
+		return false
+	}
+	if !p.DataPageHeaderV2.Equals(other.DataPageHeaderV2) {
 		return false
 	}
 	return true
 }
 
-func (p *PageEncodingStats) String() string {
+func (p *PageHeader) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PageEncodingStats(%+v)", *p)
+	return fmt.Sprintf("PageHeader(%+v)", *p)
 }
 
-// Description for column metadata
+// Wrapper struct to store key values
 //
 // Attributes:
-//  - Type: Type of this column *
-//  - Encodings: Set of all encodings used for this column. The purpose is to validate
-// whether we can decode those pages. *
-//  - PathInSchema: Path in schema *
-//  - Codec: Compression codec *
-//  - NumValues: Number of values in this column *
-//  - TotalUncompressedSize: total byte size of all uncompressed pages in this column chunk (including the headers) *
-//  - TotalCompressedSize: total byte size of all compressed pages in this column chunk (including the headers) *
-//  - KeyValueMetadata: Optional key/value metadata *
-//  - DataPageOffset: Byte offset from beginning of file to first data page *
-//  - IndexPageOffset: Byte offset from beginning of file to root index page *
-//  - DictionaryPageOffset: Byte offset from the beginning of file to first (only) dictionary page *
-//  - Statistics: optional statistics for this column chunk
-//  - EncodingStats: Set of all encodings used for pages in this column chunk.
-// This information can be used to determine if all data pages are
-// dictionary encoded for example *
-type ColumnMetaData struct {
-	Type                  Type                 `thrift:"type,1,required" db:"type" json:"type"`
-	Encodings             []Encoding           `thrift:"encodings,2,required" db:"encodings" json:"encodings"`
-	PathInSchema          []string             `thrift:"path_in_schema,3,required" db:"path_in_schema" json:"path_in_schema"`
-	Codec                 CompressionCodec     `thrift:"codec,4,required" db:"codec" json:"codec"`
-	NumValues             int64                `thrift:"num_values,5,required" db:"num_values" json:"num_values"`
-	TotalUncompressedSize int64                `thrift:"total_uncompressed_size,6,required" db:"total_uncompressed_size" json:"total_uncompressed_size"`
-	TotalCompressedSize   int64                `thrift:"total_compressed_size,7,required" db:"total_compressed_size" json:"total_compressed_size"`
-	KeyValueMetadata      []*KeyValue          `thrift:"key_value_metadata,8" db:"key_value_metadata" json:"key_value_metadata,omitempty"`
-	DataPageOffset        int64                `thrift:"data_page_offset,9,required" db:"data_page_offset" json:"data_page_offset"`
-	IndexPageOffset       *int64               `thrift:"index_page_offset,10" db:"index_page_offset" json:"index_page_offset,omitempty"`
-	DictionaryPageOffset  *int64               `thrift:"dictionary_page_offset,11" db:"dictionary_page_offset" json:"dictionary_page_offset,omitempty"`
-	Statistics            *Statistics          `thrift:"statistics,12" db:"statistics" json:"statistics,omitempty"`
-	EncodingStats         []*PageEncodingStats `thrift:"encoding_stats,13" db:"encoding_stats" json:"encoding_stats,omitempty"`
-}
-
-func NewColumnMetaData() *ColumnMetaData {
-	return &ColumnMetaData{}
-}
-
-func (p *ColumnMetaData) GetType() Type {
-	return p.Type
-}
-
-func (p *ColumnMetaData) GetEncodings() []Encoding {
-	return p.Encodings
-}
-
-func (p *ColumnMetaData) GetPathInSchema() []string {
-	return p.PathInSchema
+//  - Key
+//  - Value
+type KeyValue struct {
+	Key   string  `thrift:"key,1,required" db:"key" json:"key"`
+	Value *string `thrift:"value,2" db:"value" json:"value,omitempty"`
 }
 
-func (p *ColumnMetaData) GetCodec() CompressionCodec {
-	return p.Codec
+func NewKeyValue() *KeyValue {
+	return &KeyValue{}
 }
 
-func (p *ColumnMetaData) GetNumValues() int64 {
-	return p.NumValues
+func (p *KeyValue) GetKey() string {
+	return p.Key
 }
 
-func (p *ColumnMetaData) GetTotalUncompressedSize() int64 {
-	return p.TotalUncompressedSize
-}
+var KeyValue_Value_DEFAULT string
 
-func (p *ColumnMetaData) GetTotalCompressedSize() int64 {
-	return p.TotalCompressedSize
+func (p *KeyValue) GetValue() string {
+	if !p.IsSetValue() {
+		return KeyValue_Value_DEFAULT
+	}
+	return *p.Value
 }
-
-var ColumnMetaData_KeyValueMetadata_DEFAULT []*KeyValue
-
-func (p *ColumnMetaData) GetKeyValueMetadata() []*KeyValue {
-	return p.KeyValueMetadata
+func (p *KeyValue) IsSetValue() bool {
+	return p.Value != nil
 }
 
-func (p *ColumnMetaData) GetDataPageOffset() int64 {
-	return p.DataPageOffset
-}
+func (p *KeyValue) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-var ColumnMetaData_IndexPageOffset_DEFAULT int64
+	var issetKey bool = false
 
-func (p *ColumnMetaData) GetIndexPageOffset() int64 {
-	if !p.IsSetIndexPageOffset() {
-		return ColumnMetaData_IndexPageOffset_DEFAULT
-	}
-	return *p.IndexPageOffset
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+				issetKey = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetKey {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Key is not set"))
+	}
+	return nil
 }
 
-var ColumnMetaData_DictionaryPageOffset_DEFAULT int64
+func (p *KeyValue) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Key = v
+	}
+	return nil
+}
 
-func (p *ColumnMetaData) GetDictionaryPageOffset() int64 {
-	if !p.IsSetDictionaryPageOffset() {
-		return ColumnMetaData_DictionaryPageOffset_DEFAULT
+func (p *KeyValue) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Value = &v
 	}
-	return *p.DictionaryPageOffset
+	return nil
 }
 
-var ColumnMetaData_Statistics_DEFAULT *Statistics
+func (p *KeyValue) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "KeyValue"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
 
-func (p *ColumnMetaData) GetStatistics() *Statistics {
-	if !p.IsSetStatistics() {
-		return ColumnMetaData_Statistics_DEFAULT
+func (p *KeyValue) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "key", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:key: ", p), err)
 	}
-	return p.Statistics
+	if err := oprot.WriteString(ctx, string(p.Key)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.key (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:key: ", p), err)
+	}
+	return err
 }
 
-var ColumnMetaData_EncodingStats_DEFAULT []*PageEncodingStats
+func (p *KeyValue) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err := oprot.WriteFieldBegin(ctx, "value", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:value: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Value)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.value (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:value: ", p), err)
+		}
+	}
+	return err
+}
 
-func (p *ColumnMetaData) GetEncodingStats() []*PageEncodingStats {
-	return p.EncodingStats
+func (p *KeyValue) Equals(other *KeyValue) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Key != other.Key {
+		return false
+	}
+	if p.Value != other.Value {
+		if p.Value == nil || other.Value == nil {
+			return false
+		}
+		if (*p.Value) != (*other.Value) {
+			return false
+		}
+	}
+	return true
 }
-func (p *ColumnMetaData) IsSetKeyValueMetadata() bool {
-	return p.KeyValueMetadata != nil
+
+func (p *KeyValue) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KeyValue(%+v)", *p)
 }
 
-func (p *ColumnMetaData) IsSetIndexPageOffset() bool {
-	return p.IndexPageOffset != nil
+// Wrapper struct to specify sort order
+//
+// Attributes:
+//  - ColumnIdx: The column index (in this row group) *
+//  - Descending: If true, indicates this column is sorted in descending order. *
+//  - NullsFirst: If true, nulls will come before non-null values, otherwise,
+// nulls go at the end.
+type SortingColumn struct {
+	ColumnIdx  int32 `thrift:"column_idx,1,required" db:"column_idx" json:"column_idx"`
+	Descending bool  `thrift:"descending,2,required" db:"descending" json:"descending"`
+	NullsFirst bool  `thrift:"nulls_first,3,required" db:"nulls_first" json:"nulls_first"`
 }
 
-func (p *ColumnMetaData) IsSetDictionaryPageOffset() bool {
-	return p.DictionaryPageOffset != nil
+func NewSortingColumn() *SortingColumn {
+	return &SortingColumn{}
 }
 
-func (p *ColumnMetaData) IsSetStatistics() bool {
-	return p.Statistics != nil
+func (p *SortingColumn) GetColumnIdx() int32 {
+	return p.ColumnIdx
 }
 
-func (p *ColumnMetaData) IsSetEncodingStats() bool {
-	return p.EncodingStats != nil
+func (p *SortingColumn) GetDescending() bool {
+	return p.Descending
 }
 
-func (p *ColumnMetaData) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SortingColumn) GetNullsFirst() bool {
+	return p.NullsFirst
+}
+func (p *SortingColumn) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetType bool = false
-	var issetEncodings bool = false
-	var issetPathInSchema bool = false
-	var issetCodec bool = false
-	var issetNumValues bool = false
-	var issetTotalUncompressedSize bool = false
-	var issetTotalCompressedSize bool = false
-	var issetDataPageOffset bool = false
+	var issetColumnIdx bool = false
+	var issetDescending bool = false
+	var issetNullsFirst bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
@@ -6652,142 +7020,37 @@ func (p *ColumnMetaData) Read(ctx context.Context, iprot thrift.TProtocol) error
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
-				issetType = true
+				issetColumnIdx = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.BOOL {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
-				issetEncodings = true
+				issetDescending = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.BOOL {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
-				issetPathInSchema = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
-				issetCodec = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-				issetNumValues = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-				issetTotalUncompressedSize = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField7(ctx, iprot); err != nil {
-					return err
-				}
-				issetTotalCompressedSize = true
+				issetNullsFirst = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
-		case 8:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField8(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField9(ctx, iprot); err != nil {
-					return err
-				}
-				issetDataPageOffset = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 10:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField10(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField11(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 12:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField12(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 13:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField13(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
 			}
 		}
 		if err := iprot.ReadFieldEnd(ctx); err != nil {
@@ -6797,202 +7060,266 @@ func (p *ColumnMetaData) Read(ctx context.Context, iprot thrift.TProtocol) error
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetType {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Type is not set"))
-	}
-	if !issetEncodings {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encodings is not set"))
-	}
-	if !issetPathInSchema {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PathInSchema is not set"))
-	}
-	if !issetCodec {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Codec is not set"))
-	}
-	if !issetNumValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
-	}
-	if !issetTotalUncompressedSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalUncompressedSize is not set"))
+	if !issetColumnIdx {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ColumnIdx is not set"))
 	}
-	if !issetTotalCompressedSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalCompressedSize is not set"))
+	if !issetDescending {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Descending is not set"))
 	}
-	if !issetDataPageOffset {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DataPageOffset is not set"))
+	if !issetNullsFirst {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullsFirst is not set"))
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *SortingColumn) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		temp := Type(v)
-		p.Type = temp
+		p.ColumnIdx = v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]Encoding, 0, size)
-	p.Encodings = tSlice
-	for i := 0; i < size; i++ {
-		var _elem0 Encoding
-		if v, err := iprot.ReadI32(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			temp := Encoding(v)
-			_elem0 = temp
-		}
-		p.Encodings = append(p.Encodings, _elem0)
+func (p *SortingColumn) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Descending = v
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	return nil
+}
+
+func (p *SortingColumn) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NullsFirst = v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *SortingColumn) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SortingColumn"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	tSlice := make([]string, 0, size)
-	p.PathInSchema = tSlice
-	for i := 0; i < size; i++ {
-		var _elem1 string
-		if v, err := iprot.ReadString(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem1 = v
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
 		}
-		p.PathInSchema = append(p.PathInSchema, _elem1)
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	return nil
-}
-
-func (p *ColumnMetaData) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		temp := CompressionCodec(v)
-		p.Codec = temp
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.NumValues = v
+func (p *SortingColumn) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "column_idx", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:column_idx: ", p), err)
 	}
-	return nil
-}
-
-func (p *ColumnMetaData) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.TotalUncompressedSize = v
+	if err := oprot.WriteI32(ctx, int32(p.ColumnIdx)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.column_idx (1) field write error: ", p), err)
 	}
-	return nil
-}
-
-func (p *ColumnMetaData) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
-	} else {
-		p.TotalCompressedSize = v
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:column_idx: ", p), err)
 	}
-	return nil
+	return err
 }
 
-func (p *ColumnMetaData) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *SortingColumn) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "descending", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:descending: ", p), err)
 	}
-	tSlice := make([]*KeyValue, 0, size)
-	p.KeyValueMetadata = tSlice
-	for i := 0; i < size; i++ {
-		_elem2 := &KeyValue{}
-		if err := _elem2.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
-		}
-		p.KeyValueMetadata = append(p.KeyValueMetadata, _elem2)
+	if err := oprot.WriteBool(ctx, bool(p.Descending)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.descending (2) field write error: ", p), err)
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:descending: ", p), err)
 	}
-	return nil
+	return err
 }
 
-func (p *ColumnMetaData) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 9: ", err)
-	} else {
-		p.DataPageOffset = v
+func (p *SortingColumn) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "nulls_first", thrift.BOOL, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:nulls_first: ", p), err)
 	}
-	return nil
+	if err := oprot.WriteBool(ctx, bool(p.NullsFirst)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nulls_first (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:nulls_first: ", p), err)
+	}
+	return err
 }
 
-func (p *ColumnMetaData) ReadField10(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 10: ", err)
-	} else {
-		p.IndexPageOffset = &v
+func (p *SortingColumn) Equals(other *SortingColumn) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return nil
+	if p.ColumnIdx != other.ColumnIdx {
+		return false
+	}
+	if p.Descending != other.Descending {
+		return false
+	}
+	if p.NullsFirst != other.NullsFirst {
+		return false
+	}
+	return true
 }
 
-func (p *ColumnMetaData) ReadField11(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 11: ", err)
-	} else {
-		p.DictionaryPageOffset = &v
+func (p *SortingColumn) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
+	return fmt.Sprintf("SortingColumn(%+v)", *p)
 }
 
-func (p *ColumnMetaData) ReadField12(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Statistics = &Statistics{}
-	if err := p.Statistics.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Statistics), err)
+// statistics of a given page type and encoding
+//
+// Attributes:
+//  - PageType: the page type (data/dic/...) *
+//  - Encoding: encoding of the page *
+//  - Count: number of pages of this type with this encoding *
+type PageEncodingStats struct {
+	PageType PageType `thrift:"page_type,1,required" db:"page_type" json:"page_type"`
+	Encoding Encoding `thrift:"encoding,2,required" db:"encoding" json:"encoding"`
+	Count    int32    `thrift:"count,3,required" db:"count" json:"count"`
+}
+
+func NewPageEncodingStats() *PageEncodingStats {
+	return &PageEncodingStats{}
+}
+
+func (p *PageEncodingStats) GetPageType() PageType {
+	return p.PageType
+}
+
+func (p *PageEncodingStats) GetEncoding() Encoding {
+	return p.Encoding
+}
+
+func (p *PageEncodingStats) GetCount() int32 {
+	return p.Count
+}
+func (p *PageEncodingStats) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetPageType bool = false
+	var issetEncoding bool = false
+	var issetCount bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+				issetPageType = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+				issetEncoding = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+				issetCount = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetPageType {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageType is not set"))
+	}
+	if !issetEncoding {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
+	}
+	if !issetCount {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Count is not set"))
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) ReadField13(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *PageEncodingStats) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := PageType(v)
+		p.PageType = temp
 	}
-	tSlice := make([]*PageEncodingStats, 0, size)
-	p.EncodingStats = tSlice
-	for i := 0; i < size; i++ {
-		_elem3 := &PageEncodingStats{}
-		if err := _elem3.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
-		}
-		p.EncodingStats = append(p.EncodingStats, _elem3)
+	return nil
+}
+
+func (p *PageEncodingStats) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := Encoding(v)
+		p.Encoding = temp
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	return nil
+}
+
+func (p *PageEncodingStats) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Count = v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "ColumnMetaData"); err != nil {
+func (p *PageEncodingStats) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PageEncodingStats"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7005,36 +7332,6 @@ func (p *ColumnMetaData) Write(ctx context.Context, oprot thrift.TProtocol) erro
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField7(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField8(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField9(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField10(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField11(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField12(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField13(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7045,203 +7342,2282 @@ func (p *ColumnMetaData) Write(ctx context.Context, oprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *ColumnMetaData) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "type", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
+func (p *PageEncodingStats) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "page_type", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_type: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.Type)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.PageType)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.page_type (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_type: ", p), err)
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "encodings", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encodings: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.I32, len(p.Encodings)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Encodings {
-		if err := oprot.WriteI32(ctx, int32(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
+func (p *PageEncodingStats) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "encoding", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encoding: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteI32(ctx, int32(p.Encoding)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.encoding (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encodings: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encoding: ", p), err)
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "path_in_schema", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:path_in_schema: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.PathInSchema)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.PathInSchema {
-		if err := oprot.WriteString(ctx, string(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
+func (p *PageEncodingStats) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "count", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:count: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteI32(ctx, int32(p.Count)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.count (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:path_in_schema: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:count: ", p), err)
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "codec", thrift.I32, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:codec: ", p), err)
-	}
-	if err := oprot.WriteI32(ctx, int32(p.Codec)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.codec (4) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:codec: ", p), err)
+func (p *PageEncodingStats) Equals(other *PageEncodingStats) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return err
-}
-
-func (p *ColumnMetaData) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "num_values", thrift.I64, 5); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:num_values: ", p), err)
+	if p.PageType != other.PageType {
+		return false
 	}
-	if err := oprot.WriteI64(ctx, int64(p.NumValues)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_values (5) field write error: ", p), err)
+	if p.Encoding != other.Encoding {
+		return false
 	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:num_values: ", p), err)
+	if p.Count != other.Count {
+		return false
 	}
-	return err
+	return true
 }
 
-func (p *ColumnMetaData) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "total_uncompressed_size", thrift.I64, 6); err != nil {
+func (p *PageEncodingStats) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PageEncodingStats(%+v)", *p)
+}
+
+// Description for column metadata
+//
+// Attributes:
+//  - Type: Type of this column *
+//  - Encodings: Set of all encodings used for this column. The purpose is to validate
+// whether we can decode those pages. *
+//  - PathInSchema: Path in schema *
+//  - Codec: Compression codec *
+//  - NumValues: Number of values in this column *
+//  - TotalUncompressedSize: total byte size of all uncompressed pages in this column chunk (including the headers) *
+//  - TotalCompressedSize: total byte size of all compressed pages in this column chunk (including the headers) *
+//  - KeyValueMetadata: Optional key/value metadata *
+//  - DataPageOffset: Byte offset from beginning of file to first data page *
+//  - IndexPageOffset: Byte offset from beginning of file to root index page *
+//  - DictionaryPageOffset: Byte offset from the beginning of file to first (only) dictionary page *
+//  - Statistics: optional statistics for this column chunk
+//  - EncodingStats: Set of all encodings used for pages in this column chunk.
+// This information can be used to determine if all data pages are
+// dictionary encoded for example *
+//  - BloomFilterOffset: Byte offset from beginning of file to Bloom filter data. *
+type ColumnMetaData struct {
+	Type                  Type                 `thrift:"type,1,required" db:"type" json:"type"`
+	Encodings             []Encoding           `thrift:"encodings,2,required" db:"encodings" json:"encodings"`
+	PathInSchema          []string             `thrift:"path_in_schema,3,required" db:"path_in_schema" json:"path_in_schema"`
+	Codec                 CompressionCodec     `thrift:"codec,4,required" db:"codec" json:"codec"`
+	NumValues             int64                `thrift:"num_values,5,required" db:"num_values" json:"num_values"`
+	TotalUncompressedSize int64                `thrift:"total_uncompressed_size,6,required" db:"total_uncompressed_size" json:"total_uncompressed_size"`
+	TotalCompressedSize   int64                `thrift:"total_compressed_size,7,required" db:"total_compressed_size" json:"total_compressed_size"`
+	KeyValueMetadata      []*KeyValue          `thrift:"key_value_metadata,8" db:"key_value_metadata" json:"key_value_metadata,omitempty"`
+	DataPageOffset        int64                `thrift:"data_page_offset,9,required" db:"data_page_offset" json:"data_page_offset"`
+	IndexPageOffset       *int64               `thrift:"index_page_offset,10" db:"index_page_offset" json:"index_page_offset,omitempty"`
+	DictionaryPageOffset  *int64               `thrift:"dictionary_page_offset,11" db:"dictionary_page_offset" json:"dictionary_page_offset,omitempty"`
+	Statistics            *Statistics          `thrift:"statistics,12" db:"statistics" json:"statistics,omitempty"`
+	EncodingStats         []*PageEncodingStats `thrift:"encoding_stats,13" db:"encoding_stats" json:"encoding_stats,omitempty"`
+	BloomFilterOffset     *int64               `thrift:"bloom_filter_offset,14" db:"bloom_filter_offset" json:"bloom_filter_offset,omitempty"`
+}
+
+func NewColumnMetaData() *ColumnMetaData {
+	return &ColumnMetaData{}
+}
+
+func (p *ColumnMetaData) GetType() Type {
+	return p.Type
+}
+
+func (p *ColumnMetaData) GetEncodings() []Encoding {
+	return p.Encodings
+}
+
+func (p *ColumnMetaData) GetPathInSchema() []string {
+	return p.PathInSchema
+}
+
+func (p *ColumnMetaData) GetCodec() CompressionCodec {
+	return p.Codec
+}
+
+func (p *ColumnMetaData) GetNumValues() int64 {
+	return p.NumValues
+}
+
+func (p *ColumnMetaData) GetTotalUncompressedSize() int64 {
+	return p.TotalUncompressedSize
+}
+
+func (p *ColumnMetaData) GetTotalCompressedSize() int64 {
+	return p.TotalCompressedSize
+}
+
+var ColumnMetaData_KeyValueMetadata_DEFAULT []*KeyValue
+
+func (p *ColumnMetaData) GetKeyValueMetadata() []*KeyValue {
+	return p.KeyValueMetadata
+}
+
+func (p *ColumnMetaData) GetDataPageOffset() int64 {
+	return p.DataPageOffset
+}
+
+var ColumnMetaData_IndexPageOffset_DEFAULT int64
+
+func (p *ColumnMetaData) GetIndexPageOffset() int64 {
+	if !p.IsSetIndexPageOffset() {
+		return ColumnMetaData_IndexPageOffset_DEFAULT
+	}
+	return *p.IndexPageOffset
+}
+
+var ColumnMetaData_DictionaryPageOffset_DEFAULT int64
+
+func (p *ColumnMetaData) GetDictionaryPageOffset() int64 {
+	if !p.IsSetDictionaryPageOffset() {
+		return ColumnMetaData_DictionaryPageOffset_DEFAULT
+	}
+	return *p.DictionaryPageOffset
+}
+
+var ColumnMetaData_Statistics_DEFAULT *Statistics
+
+func (p *ColumnMetaData) GetStatistics() *Statistics {
+	if !p.IsSetStatistics() {
+		return ColumnMetaData_Statistics_DEFAULT
+	}
+	return p.Statistics
+}
+
+var ColumnMetaData_EncodingStats_DEFAULT []*PageEncodingStats
+
+func (p *ColumnMetaData) GetEncodingStats() []*PageEncodingStats {
+	return p.EncodingStats
+}
+
+var ColumnMetaData_BloomFilterOffset_DEFAULT int64
+
+func (p *ColumnMetaData) GetBloomFilterOffset() int64 {
+	if !p.IsSetBloomFilterOffset() {
+		return ColumnMetaData_BloomFilterOffset_DEFAULT
+	}
+	return *p.BloomFilterOffset
+}
+func (p *ColumnMetaData) IsSetKeyValueMetadata() bool {
+	return p.KeyValueMetadata != nil
+}
+
+func (p *ColumnMetaData) IsSetIndexPageOffset() bool {
+	return p.IndexPageOffset != nil
+}
+
+func (p *ColumnMetaData) IsSetDictionaryPageOffset() bool {
+	return p.DictionaryPageOffset != nil
+}
+
+func (p *ColumnMetaData) IsSetStatistics() bool {
+	return p.Statistics != nil
+}
+
+func (p *ColumnMetaData) IsSetEncodingStats() bool {
+	return p.EncodingStats != nil
+}
+
+func (p *ColumnMetaData) IsSetBloomFilterOffset() bool {
+	return p.BloomFilterOffset != nil
+}
+
+func (p *ColumnMetaData) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetType bool = false
+	var issetEncodings bool = false
+	var issetPathInSchema bool = false
+	var issetCodec bool = false
+	var issetNumValues bool = false
+	var issetTotalUncompressedSize bool = false
+	var issetTotalCompressedSize bool = false
+	var issetDataPageOffset bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+				issetType = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+				issetEncodings = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+				issetPathInSchema = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+				issetCodec = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+				issetNumValues = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+				issetTotalUncompressedSize = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+				issetTotalCompressedSize = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+				issetDataPageOffset = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField10(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField11(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField12(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField13(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 14:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField14(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetType {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Type is not set"))
+	}
+	if !issetEncodings {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encodings is not set"))
+	}
+	if !issetPathInSchema {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PathInSchema is not set"))
+	}
+	if !issetCodec {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Codec is not set"))
+	}
+	if !issetNumValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
+	}
+	if !issetTotalUncompressedSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalUncompressedSize is not set"))
+	}
+	if !issetTotalCompressedSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalCompressedSize is not set"))
+	}
+	if !issetDataPageOffset {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DataPageOffset is not set"))
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := Type(v)
+		p.Type = temp
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]Encoding, 0, size)
+	p.Encodings = tSlice
+	for i := 0; i < size; i++ {
+		var _elem0 Encoding
+		if v, err := iprot.ReadI32(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := Encoding(v)
+			_elem0 = temp
+		}
+		p.Encodings = append(p.Encodings, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.PathInSchema = tSlice
+	for i := 0; i < size; i++ {
+		var _elem1 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem1 = v
+		}
+		p.PathInSchema = append(p.PathInSchema, _elem1)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := CompressionCodec(v)
+		p.Codec = temp
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.NumValues = v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.TotalUncompressedSize = v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.TotalCompressedSize = v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*KeyValue, 0, size)
+	p.KeyValueMetadata = tSlice
+	for i := 0; i < size; i++ {
+		_elem2 := &KeyValue{}
+		if err := _elem2.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
+		}
+		p.KeyValueMetadata = append(p.KeyValueMetadata, _elem2)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.DataPageOffset = v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField10(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 10: ", err)
+	} else {
+		p.IndexPageOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField11(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 11: ", err)
+	} else {
+		p.DictionaryPageOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField12(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Statistics = &Statistics{}
+	if err := p.Statistics.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Statistics), err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField13(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*PageEncodingStats, 0, size)
+	p.EncodingStats = tSlice
+	for i := 0; i < size; i++ {
+		_elem3 := &PageEncodingStats{}
+		if err := _elem3.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
+		}
+		p.EncodingStats = append(p.EncodingStats, _elem3)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) ReadField14(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 14: ", err)
+	} else {
+		p.BloomFilterOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ColumnMetaData"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField10(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField11(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField12(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField13(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField14(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "type", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "encodings", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encodings: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.I32, len(p.Encodings)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Encodings {
+		if err := oprot.WriteI32(ctx, int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encodings: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "path_in_schema", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:path_in_schema: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.PathInSchema)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.PathInSchema {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:path_in_schema: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "codec", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:codec: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Codec)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.codec (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:codec: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "num_values", thrift.I64, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:num_values: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.NumValues)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_values (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:num_values: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "total_uncompressed_size", thrift.I64, 6); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:total_uncompressed_size: ", p), err)
 	}
-	if err := oprot.WriteI64(ctx, int64(p.TotalUncompressedSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.total_uncompressed_size (6) field write error: ", p), err)
+	if err := oprot.WriteI64(ctx, int64(p.TotalUncompressedSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.total_uncompressed_size (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:total_uncompressed_size: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "total_compressed_size", thrift.I64, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:total_compressed_size: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.TotalCompressedSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.total_compressed_size (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:total_compressed_size: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyValueMetadata() {
+		if err := oprot.WriteFieldBegin(ctx, "key_value_metadata", thrift.LIST, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:key_value_metadata: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.KeyValueMetadata)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.KeyValueMetadata {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:key_value_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "data_page_offset", thrift.I64, 9); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:data_page_offset: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.DataPageOffset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.data_page_offset (9) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 9:data_page_offset: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField10(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIndexPageOffset() {
+		if err := oprot.WriteFieldBegin(ctx, "index_page_offset", thrift.I64, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:index_page_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.IndexPageOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.index_page_offset (10) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:index_page_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField11(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDictionaryPageOffset() {
+		if err := oprot.WriteFieldBegin(ctx, "dictionary_page_offset", thrift.I64, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:dictionary_page_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.DictionaryPageOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.dictionary_page_offset (11) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:dictionary_page_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField12(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatistics() {
+		if err := oprot.WriteFieldBegin(ctx, "statistics", thrift.STRUCT, 12); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:statistics: ", p), err)
+		}
+		if err := p.Statistics.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Statistics), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:statistics: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField13(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetEncodingStats() {
+		if err := oprot.WriteFieldBegin(ctx, "encoding_stats", thrift.LIST, 13); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:encoding_stats: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.EncodingStats)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.EncodingStats {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 13:encoding_stats: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField14(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBloomFilterOffset() {
+		if err := oprot.WriteFieldBegin(ctx, "bloom_filter_offset", thrift.I64, 14); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 14:bloom_filter_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.BloomFilterOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.bloom_filter_offset (14) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 14:bloom_filter_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) Equals(other *ColumnMetaData) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Type != other.Type {
+		return false
+	}
+	if len(p.Encodings) != len(other.Encodings) {
+		return false
+	}
+	for i, _tgt := range p.Encodings {
+		_src4 := other.Encodings[i]
+		if _tgt != _src4 {
+			return false
+		}
+	}
+	if len(p.PathInSchema) != len(other.PathInSchema) {
+		return false
+	}
+	for i, _tgt := range p.PathInSchema {
+		_src5 := other.PathInSchema[i]
+		if _tgt != _src5 {
+			return false
+		}
+	}
+	if p.Codec != other.Codec {
+		return false
+	}
+	if p.NumValues != other.NumValues {
+		return false
+	}
+	if p.TotalUncompressedSize != other.TotalUncompressedSize {
+		return false
+	}
+	if p.TotalCompressedSize != other.TotalCompressedSize {
+		return false
+	}
+	if len(p.KeyValueMetadata) != len(other.KeyValueMetadata) {
+		return false
+	}
+	for i, _tgt := range p.KeyValueMetadata {
+		_src6 := other.KeyValueMetadata[i]
+		if !_tgt.Equals(_src6) {
+			return false
+		}
+	}
+	if p.DataPageOffset != other.DataPageOffset {
+		return false
+	}
+	if p.IndexPageOffset != other.IndexPageOffset {
+		if p.IndexPageOffset == nil || other.IndexPageOffset == nil {
+			return false
+		}
+		if (*p.IndexPageOffset) != (*other.IndexPageOffset) {
+			return false
+		}
+	}
+	if p.DictionaryPageOffset != other.DictionaryPageOffset {
+		if p.DictionaryPageOffset == nil || other.DictionaryPageOffset == nil {
+			return false
+		}
+		if (*p.DictionaryPageOffset) != (*other.DictionaryPageOffset) {
+			return false
+		}
+	}
+	if !p.Statistics.Equals(other.Statistics) {
+		return false
+	}
+	if len(p.EncodingStats) != len(other.EncodingStats) {
+		return false
+	}
+	for i, _tgt := range p.EncodingStats {
+		_src7 := other.EncodingStats[i]
+		if !_tgt.Equals(_src7) {
+			return false
+		}
+	}
+	if p.BloomFilterOffset != other.BloomFilterOffset {
+		if p.BloomFilterOffset == nil || other.BloomFilterOffset == nil {
+			return false
+		}
+		if (*p.BloomFilterOffset) != (*other.BloomFilterOffset) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *ColumnMetaData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnMetaData(%+v)", *p)
+}
+
+type EncryptionWithFooterKey struct {
+}
+
+func NewEncryptionWithFooterKey() *EncryptionWithFooterKey {
+	return &EncryptionWithFooterKey{}
+}
+
+func (p *EncryptionWithFooterKey) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *EncryptionWithFooterKey) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "EncryptionWithFooterKey"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *EncryptionWithFooterKey) Equals(other *EncryptionWithFooterKey) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	return true
+}
+
+func (p *EncryptionWithFooterKey) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EncryptionWithFooterKey(%+v)", *p)
+}
+
+
+// Attributes:
+//  - PathInSchema: Column path in schema *
+//  - KeyMetadata: Retrieval metadata of column encryption key *
+type EncryptionWithColumnKey struct {
+	PathInSchema []string `thrift:"path_in_schema,1,required" db:"path_in_schema" json:"path_in_schema"`
+	KeyMetadata  []byte   `thrift:"key_metadata,2" db:"key_metadata" json:"key_metadata,omitempty"`
+}
+
+func NewEncryptionWithColumnKey() *EncryptionWithColumnKey {
+	return &EncryptionWithColumnKey{}
+}
+
+func (p *EncryptionWithColumnKey) GetPathInSchema() []string {
+	return p.PathInSchema
+}
+
+var EncryptionWithColumnKey_KeyMetadata_DEFAULT []byte
+
+func (p *EncryptionWithColumnKey) GetKeyMetadata() []byte {
+	return p.KeyMetadata
+}
+func (p *EncryptionWithColumnKey) IsSetKeyMetadata() bool {
+	return p.KeyMetadata != nil
+}
+
+func (p *EncryptionWithColumnKey) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetPathInSchema bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+				issetPathInSchema = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetPathInSchema {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PathInSchema is not set"))
+	}
+	return nil
+}
+
+func (p *EncryptionWithColumnKey) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.PathInSchema = tSlice
+	for i := 0; i < size; i++ {
+		var _elem30 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem30 = v
+		}
+		p.PathInSchema = append(p.PathInSchema, _elem30)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *EncryptionWithColumnKey) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.KeyMetadata = v
+	}
+	return nil
+}
+
+func (p *EncryptionWithColumnKey) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "EncryptionWithColumnKey"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *EncryptionWithColumnKey) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "path_in_schema", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path_in_schema: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.PathInSchema)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.PathInSchema {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path_in_schema: ", p), err)
+	}
+	return err
+}
+
+func (p *EncryptionWithColumnKey) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyMetadata() {
+		if err := oprot.WriteFieldBegin(ctx, "key_metadata", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:key_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.KeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.key_metadata (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:key_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *EncryptionWithColumnKey) Equals(other *EncryptionWithColumnKey) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.PathInSchema) != len(other.PathInSchema) {
+		return false
+	}
+	for i, _tgt := range p.PathInSchema {
+		_src31 := other.PathInSchema[i]
+		if _tgt != _src31 {
+			return false
+		}
+	}
+	if bytes.Compare(p.KeyMetadata, other.KeyMetadata) != 0 {
+		return false
+	}
+	return true
+}
+
+func (p *EncryptionWithColumnKey) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EncryptionWithColumnKey(%+v)", *p)
+}
+
+
+// Attributes:
+//  - ENCRYPTION_WITH_FOOTER_KEY
+//  - ENCRYPTION_WITH_COLUMN_KEY
+type ColumnCryptoMetaData struct {
+	ENCRYPTION_WITH_FOOTER_KEY *EncryptionWithFooterKey `thrift:"ENCRYPTION_WITH_FOOTER_KEY,1" db:"ENCRYPTION_WITH_FOOTER_KEY" json:"ENCRYPTION_WITH_FOOTER_KEY,omitempty"`
+	ENCRYPTION_WITH_COLUMN_KEY *EncryptionWithColumnKey `thrift:"ENCRYPTION_WITH_COLUMN_KEY,2" db:"ENCRYPTION_WITH_COLUMN_KEY" json:"ENCRYPTION_WITH_COLUMN_KEY,omitempty"`
+}
+
+func NewColumnCryptoMetaData() *ColumnCryptoMetaData {
+	return &ColumnCryptoMetaData{}
+}
+
+var ColumnCryptoMetaData_ENCRYPTION_WITH_FOOTER_KEY_DEFAULT *EncryptionWithFooterKey
+
+func (p *ColumnCryptoMetaData) GetENCRYPTION_WITH_FOOTER_KEY() *EncryptionWithFooterKey {
+	if !p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		return ColumnCryptoMetaData_ENCRYPTION_WITH_FOOTER_KEY_DEFAULT
+	}
+	return p.ENCRYPTION_WITH_FOOTER_KEY
+}
+
+var ColumnCryptoMetaData_ENCRYPTION_WITH_COLUMN_KEY_DEFAULT *EncryptionWithColumnKey
+
+func (p *ColumnCryptoMetaData) GetENCRYPTION_WITH_COLUMN_KEY() *EncryptionWithColumnKey {
+	if !p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		return ColumnCryptoMetaData_ENCRYPTION_WITH_COLUMN_KEY_DEFAULT
+	}
+	return p.ENCRYPTION_WITH_COLUMN_KEY
+}
+func (p *ColumnCryptoMetaData) CountSetFieldsColumnCryptoMetaData() int {
+	count := 0
+	if p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		count++
+	}
+	if p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		count++
+	}
+	return count
+
+}
+
+func (p *ColumnCryptoMetaData) IsSetENCRYPTION_WITH_FOOTER_KEY() bool {
+	return p.ENCRYPTION_WITH_FOOTER_KEY != nil
+}
+
+func (p *ColumnCryptoMetaData) IsSetENCRYPTION_WITH_COLUMN_KEY() bool {
+	return p.ENCRYPTION_WITH_COLUMN_KEY != nil
+}
+
+func (p *ColumnCryptoMetaData) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ColumnCryptoMetaData) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ENCRYPTION_WITH_FOOTER_KEY = &EncryptionWithFooterKey{}
+	if err := p.ENCRYPTION_WITH_FOOTER_KEY.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ENCRYPTION_WITH_FOOTER_KEY), err)
+	}
+	return nil
+}
+
+func (p *ColumnCryptoMetaData) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ENCRYPTION_WITH_COLUMN_KEY = &EncryptionWithColumnKey{}
+	if err := p.ENCRYPTION_WITH_COLUMN_KEY.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ENCRYPTION_WITH_COLUMN_KEY), err)
+	}
+	return nil
+}
+
+func (p *ColumnCryptoMetaData) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsColumnCryptoMetaData(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set)", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "ColumnCryptoMetaData"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnCryptoMetaData) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		if err := oprot.WriteFieldBegin(ctx, "ENCRYPTION_WITH_FOOTER_KEY", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ENCRYPTION_WITH_FOOTER_KEY: ", p), err)
+		}
+		if err := p.ENCRYPTION_WITH_FOOTER_KEY.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ENCRYPTION_WITH_FOOTER_KEY), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ENCRYPTION_WITH_FOOTER_KEY: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnCryptoMetaData) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		if err := oprot.WriteFieldBegin(ctx, "ENCRYPTION_WITH_COLUMN_KEY", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ENCRYPTION_WITH_COLUMN_KEY: ", p), err)
+		}
+		if err := p.ENCRYPTION_WITH_COLUMN_KEY.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ENCRYPTION_WITH_COLUMN_KEY), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ENCRYPTION_WITH_COLUMN_KEY: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnCryptoMetaData) Equals(other *ColumnCryptoMetaData) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.ENCRYPTION_WITH_FOOTER_KEY.Equals(other.ENCRYPTION_WITH_FOOTER_KEY) {
+		return false
+	}
+	if !p.ENCRYPTION_WITH_COLUMN_KEY.Equals(other.ENCRYPTION_WITH_COLUMN_KEY) {
+		return false
+	}
+	return true
+}
+
+func (p *ColumnCryptoMetaData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnCryptoMetaData(%+v)", *p)
+}
+
+
+// Attributes:
+//  - FilePath: File where column data is stored.  If not set, assumed to be same file as
+// metadata.  This path is relative to the current file.
+//
+//  - FileOffset: Byte offset in file_path to the ColumnMetaData *
+//  - MetaData: Column metadata for this chunk. This is the same content as what is at
+// file_path/file_offset.  Having it here has it replicated in the file
+// metadata.
+//
+//  - OffsetIndexOffset: File offset of ColumnChunk's OffsetIndex *
+//  - OffsetIndexLength: Size of ColumnChunk's OffsetIndex, in bytes *
+//  - ColumnIndexOffset: File offset of ColumnChunk's ColumnIndex *
+//  - ColumnIndexLength: Size of ColumnChunk's ColumnIndex, in bytes *
+//  - CryptoMetadata: Crypto metadata of encrypted columns *
+//  - EncryptedColumnMetadata: Encrypted column metadata for this chunk *
+type ColumnChunk struct {
+	FilePath                *string               `thrift:"file_path,1" db:"file_path" json:"file_path,omitempty"`
+	FileOffset              int64                 `thrift:"file_offset,2,required" db:"file_offset" json:"file_offset"`
+	MetaData                *ColumnMetaData       `thrift:"meta_data,3" db:"meta_data" json:"meta_data,omitempty"`
+	OffsetIndexOffset       *int64                `thrift:"offset_index_offset,4" db:"offset_index_offset" json:"offset_index_offset,omitempty"`
+	OffsetIndexLength       *int32                `thrift:"offset_index_length,5" db:"offset_index_length" json:"offset_index_length,omitempty"`
+	ColumnIndexOffset       *int64                `thrift:"column_index_offset,6" db:"column_index_offset" json:"column_index_offset,omitempty"`
+	ColumnIndexLength       *int32                `thrift:"column_index_length,7" db:"column_index_length" json:"column_index_length,omitempty"`
+	CryptoMetadata          *ColumnCryptoMetaData `thrift:"crypto_metadata,8" db:"crypto_metadata" json:"crypto_metadata,omitempty"`
+	EncryptedColumnMetadata []byte                `thrift:"encrypted_column_metadata,9" db:"encrypted_column_metadata" json:"encrypted_column_metadata,omitempty"`
+}
+
+func NewColumnChunk() *ColumnChunk {
+	return &ColumnChunk{}
+}
+
+var ColumnChunk_FilePath_DEFAULT string
+
+func (p *ColumnChunk) GetFilePath() string {
+	if !p.IsSetFilePath() {
+		return ColumnChunk_FilePath_DEFAULT
+	}
+	return *p.FilePath
+}
+
+func (p *ColumnChunk) GetFileOffset() int64 {
+	return p.FileOffset
+}
+
+var ColumnChunk_MetaData_DEFAULT *ColumnMetaData
+
+func (p *ColumnChunk) GetMetaData() *ColumnMetaData {
+	if !p.IsSetMetaData() {
+		return ColumnChunk_MetaData_DEFAULT
+	}
+	return p.MetaData
+}
+
+var ColumnChunk_OffsetIndexOffset_DEFAULT int64
+
+func (p *ColumnChunk) GetOffsetIndexOffset() int64 {
+	if !p.IsSetOffsetIndexOffset() {
+		return ColumnChunk_OffsetIndexOffset_DEFAULT
+	}
+	return *p.OffsetIndexOffset
+}
+
+var ColumnChunk_OffsetIndexLength_DEFAULT int32
+
+func (p *ColumnChunk) GetOffsetIndexLength() int32 {
+	if !p.IsSetOffsetIndexLength() {
+		return ColumnChunk_OffsetIndexLength_DEFAULT
+	}
+	return *p.OffsetIndexLength
+}
+
+var ColumnChunk_ColumnIndexOffset_DEFAULT int64
+
+func (p *ColumnChunk) GetColumnIndexOffset() int64 {
+	if !p.IsSetColumnIndexOffset() {
+		return ColumnChunk_ColumnIndexOffset_DEFAULT
+	}
+	return *p.ColumnIndexOffset
+}
+
+var ColumnChunk_ColumnIndexLength_DEFAULT int32
+
+func (p *ColumnChunk) GetColumnIndexLength() int32 {
+	if !p.IsSetColumnIndexLength() {
+		return ColumnChunk_ColumnIndexLength_DEFAULT
+	}
+	return *p.ColumnIndexLength
+}
+
+var ColumnChunk_CryptoMetadata_DEFAULT *ColumnCryptoMetaData
+
+func (p *ColumnChunk) GetCryptoMetadata() *ColumnCryptoMetaData {
+	if !p.IsSetCryptoMetadata() {
+		return ColumnChunk_CryptoMetadata_DEFAULT
+	}
+	return p.CryptoMetadata
+}
+
+var ColumnChunk_EncryptedColumnMetadata_DEFAULT []byte
+
+func (p *ColumnChunk) GetEncryptedColumnMetadata() []byte {
+	return p.EncryptedColumnMetadata
+}
+func (p *ColumnChunk) IsSetFilePath() bool {
+	return p.FilePath != nil
+}
+
+func (p *ColumnChunk) IsSetMetaData() bool {
+	return p.MetaData != nil
+}
+
+func (p *ColumnChunk) IsSetOffsetIndexOffset() bool {
+	return p.OffsetIndexOffset != nil
+}
+
+func (p *ColumnChunk) IsSetOffsetIndexLength() bool {
+	return p.OffsetIndexLength != nil
+}
+
+func (p *ColumnChunk) IsSetColumnIndexOffset() bool {
+	return p.ColumnIndexOffset != nil
+}
+
+func (p *ColumnChunk) IsSetColumnIndexLength() bool {
+	return p.ColumnIndexLength != nil
+}
+
+func (p *ColumnChunk) IsSetCryptoMetadata() bool {
+	return p.CryptoMetadata != nil
+}
+
+func (p *ColumnChunk) IsSetEncryptedColumnMetadata() bool {
+	return p.EncryptedColumnMetadata != nil
+}
+
+func (p *ColumnChunk) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetFileOffset bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+				issetFileOffset = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetFileOffset {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FileOffset is not set"))
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.FilePath = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.FileOffset = v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.MetaData = &ColumnMetaData{}
+	if err := p.MetaData.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MetaData), err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.OffsetIndexOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.OffsetIndexLength = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ColumnIndexOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.ColumnIndexLength = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	p.CryptoMetadata = &ColumnCryptoMetaData{}
+	if err := p.CryptoMetadata.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CryptoMetadata), err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.EncryptedColumnMetadata = v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ColumnChunk"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilePath() {
+		if err := oprot.WriteFieldBegin(ctx, "file_path", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:file_path: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.FilePath)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.file_path (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:file_path: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "file_offset", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:file_offset: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.FileOffset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.file_offset (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:file_offset: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetaData() {
+		if err := oprot.WriteFieldBegin(ctx, "meta_data", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:meta_data: ", p), err)
+		}
+		if err := p.MetaData.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MetaData), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:meta_data: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffsetIndexOffset() {
+		if err := oprot.WriteFieldBegin(ctx, "offset_index_offset", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:offset_index_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.OffsetIndexOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offset_index_offset (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:offset_index_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffsetIndexLength() {
+		if err := oprot.WriteFieldBegin(ctx, "offset_index_length", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:offset_index_length: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OffsetIndexLength)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offset_index_length (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:offset_index_length: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnIndexOffset() {
+		if err := oprot.WriteFieldBegin(ctx, "column_index_offset", thrift.I64, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:column_index_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.ColumnIndexOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.column_index_offset (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:column_index_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnIndexLength() {
+		if err := oprot.WriteFieldBegin(ctx, "column_index_length", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:column_index_length: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.ColumnIndexLength)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.column_index_length (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:column_index_length: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetCryptoMetadata() {
+		if err := oprot.WriteFieldBegin(ctx, "crypto_metadata", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:crypto_metadata: ", p), err)
+		}
+		if err := p.CryptoMetadata.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CryptoMetadata), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:crypto_metadata: ", p), err)
+		}
 	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:total_uncompressed_size: ", p), err)
+	return err
+}
+
+func (p *ColumnChunk) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetEncryptedColumnMetadata() {
+		if err := oprot.WriteFieldBegin(ctx, "encrypted_column_metadata", thrift.STRING, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:encrypted_column_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.EncryptedColumnMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.encrypted_column_metadata (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:encrypted_column_metadata: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "total_compressed_size", thrift.I64, 7); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:total_compressed_size: ", p), err)
+func (p *ColumnChunk) Equals(other *ColumnChunk) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	if err := oprot.WriteI64(ctx, int64(p.TotalCompressedSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.total_compressed_size (7) field write error: ", p), err)
+	if p.FilePath != other.FilePath {
+		if p.FilePath == nil || other.FilePath == nil {
+			return false
+		}
+		if (*p.FilePath) != (*other.FilePath) {
+			return false
+		}
+	}
+	if p.FileOffset != other.FileOffset {
+		return false
+	}
+	if !p.MetaData.Equals(other.MetaData) {
+		return false
+	}
+	if p.OffsetIndexOffset != other.OffsetIndexOffset {
+		if p.OffsetIndexOffset == nil || other.OffsetIndexOffset == nil {
+			return false
+		}
+		if (*p.OffsetIndexOffset) != (*other.OffsetIndexOffset) {
+			return false
+		}
+	}
+	if p.OffsetIndexLength != other.OffsetIndexLength {
+		if p.OffsetIndexLength == nil || other.OffsetIndexLength == nil {
+			return false
+		}
+		if (*p.OffsetIndexLength) != (*other.OffsetIndexLength) {
+			return false
+		}
+	}
+	if p.ColumnIndexOffset != other.ColumnIndexOffset {
+		if p.ColumnIndexOffset == nil || other.ColumnIndexOffset == nil {
+			return false
+		}
+		if (*p.ColumnIndexOffset) != (*other.ColumnIndexOffset) {
+			return false
+		}
+	}
+	if p.ColumnIndexLength != other.ColumnIndexLength {
+		if p.ColumnIndexLength == nil || other.ColumnIndexLength == nil {
+			return false
+		}
+		if (*p.ColumnIndexLength) != (*other.ColumnIndexLength) {
+			return false
+		}
+	}
+	if !p.CryptoMetadata.Equals(other.CryptoMetadata) {
+		return false
+	}
+	if bytes.Compare(p.EncryptedColumnMetadata, other.EncryptedColumnMetadata) != 0 {
+		return false
+	}
+	return true
+}
+
+func (p *ColumnChunk) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnChunk(%+v)", *p)
+}
+
+
+// Attributes:
+//  - Columns: Metadata for each column chunk in this row group.
+// This list must have the same order as the SchemaElement list in FileMetaData.
+//
+//  - TotalByteSize: Total byte size of all the uncompressed column data in this row group *
+//  - NumRows: Number of rows in this row group *
+//  - SortingColumns: If set, specifies a sort ordering of the rows in this RowGroup.
+// The sorting columns can be a subset of all the columns.
+type RowGroup struct {
+	Columns        []*ColumnChunk   `thrift:"columns,1,required" db:"columns" json:"columns"`
+	TotalByteSize  int64            `thrift:"total_byte_size,2,required" db:"total_byte_size" json:"total_byte_size"`
+	NumRows        int64            `thrift:"num_rows,3,required" db:"num_rows" json:"num_rows"`
+	SortingColumns []*SortingColumn `thrift:"sorting_columns,4" db:"sorting_columns" json:"sorting_columns,omitempty"`
+}
+
+func NewRowGroup() *RowGroup {
+	return &RowGroup{}
+}
+
+func (p *RowGroup) GetColumns() []*ColumnChunk {
+	return p.Columns
+}
+
+func (p *RowGroup) GetTotalByteSize() int64 {
+	return p.TotalByteSize
+}
+
+func (p *RowGroup) GetNumRows() int64 {
+	return p.NumRows
+}
+
+var RowGroup_SortingColumns_DEFAULT []*SortingColumn
+
+func (p *RowGroup) GetSortingColumns() []*SortingColumn {
+	return p.SortingColumns
+}
+func (p *RowGroup) IsSetSortingColumns() bool {
+	return p.SortingColumns != nil
+}
+
+func (p *RowGroup) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetColumns bool = false
+	var issetTotalByteSize bool = false
+	var issetNumRows bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+				issetColumns = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+				issetTotalByteSize = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+				issetNumRows = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetColumns {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Columns is not set"))
+	}
+	if !issetTotalByteSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalByteSize is not set"))
+	}
+	if !issetNumRows {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
+	}
+	return nil
+}
+
+func (p *RowGroup) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*ColumnChunk, 0, size)
+	p.Columns = tSlice
+	for i := 0; i < size; i++ {
+		_elem8 := &ColumnChunk{}
+		if err := _elem8.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
+		}
+		p.Columns = append(p.Columns, _elem8)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RowGroup) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.TotalByteSize = v
+	}
+	return nil
+}
+
+func (p *RowGroup) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NumRows = v
+	}
+	return nil
+}
+
+func (p *RowGroup) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*SortingColumn, 0, size)
+	p.SortingColumns = tSlice
+	for i := 0; i < size; i++ {
+		_elem9 := &SortingColumn{}
+		if err := _elem9.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem9), err)
+		}
+		p.SortingColumns = append(p.SortingColumns, _elem9)
 	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:total_compressed_size: ", p), err)
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
-	return err
+	return nil
 }
 
-func (p *ColumnMetaData) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyValueMetadata() {
-		if err := oprot.WriteFieldBegin(ctx, "key_value_metadata", thrift.LIST, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:key_value_metadata: ", p), err)
-		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.KeyValueMetadata)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
+func (p *RowGroup) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "RowGroup"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
 		}
-		for _, v := range p.KeyValueMetadata {
-			if err := v.Write(ctx, oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
 		}
-		if err := oprot.WriteListEnd(ctx); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
 		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:key_value_metadata: ", p), err)
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *ColumnMetaData) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "data_page_offset", thrift.I64, 9); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:data_page_offset: ", p), err)
+func (p *RowGroup) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "columns", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:columns: ", p), err)
 	}
-	if err := oprot.WriteI64(ctx, int64(p.DataPageOffset)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.data_page_offset (9) field write error: ", p), err)
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Columns)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Columns {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 9:data_page_offset: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:columns: ", p), err)
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField10(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetIndexPageOffset() {
-		if err := oprot.WriteFieldBegin(ctx, "index_page_offset", thrift.I64, 10); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:index_page_offset: ", p), err)
-		}
-		if err := oprot.WriteI64(ctx, int64(*p.IndexPageOffset)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.index_page_offset (10) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:index_page_offset: ", p), err)
-		}
+func (p *RowGroup) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "total_byte_size", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:total_byte_size: ", p), err)
 	}
-	return err
-}
-
-func (p *ColumnMetaData) writeField11(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetDictionaryPageOffset() {
-		if err := oprot.WriteFieldBegin(ctx, "dictionary_page_offset", thrift.I64, 11); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:dictionary_page_offset: ", p), err)
-		}
-		if err := oprot.WriteI64(ctx, int64(*p.DictionaryPageOffset)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.dictionary_page_offset (11) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:dictionary_page_offset: ", p), err)
-		}
+	if err := oprot.WriteI64(ctx, int64(p.TotalByteSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.total_byte_size (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:total_byte_size: ", p), err)
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField12(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatistics() {
-		if err := oprot.WriteFieldBegin(ctx, "statistics", thrift.STRUCT, 12); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:statistics: ", p), err)
-		}
-		if err := p.Statistics.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Statistics), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:statistics: ", p), err)
-		}
+func (p *RowGroup) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "num_rows", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.NumRows)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField13(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetEncodingStats() {
-		if err := oprot.WriteFieldBegin(ctx, "encoding_stats", thrift.LIST, 13); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:encoding_stats: ", p), err)
+func (p *RowGroup) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSortingColumns() {
+		if err := oprot.WriteFieldBegin(ctx, "sorting_columns", thrift.LIST, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:sorting_columns: ", p), err)
 		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.EncodingStats)); err != nil {
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.SortingColumns)); err != nil {
 			return thrift.PrependError("error writing list begin: ", err)
 		}
-		for _, v := range p.EncodingStats {
+		for _, v := range p.SortingColumns {
 			if err := v.Write(ctx, oprot); err != nil {
 				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 			}
@@ -7250,216 +9626,204 @@ func (p *ColumnMetaData) writeField13(ctx context.Context, oprot thrift.TProtoco
 			return thrift.PrependError("error writing list end: ", err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 13:encoding_stats: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:sorting_columns: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnMetaData) Equals(other *ColumnMetaData) bool {
+func (p *RowGroup) Equals(other *RowGroup) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.Type != other.Type {
-		return false
-	}
-	if len(p.Encodings) != len(other.Encodings) {
-		return false
-	}
-	for i, _tgt := range p.Encodings {
-		_src4 := other.Encodings[i]
-		if _tgt != _src4 {
-			return false
-		}
-	}
-	if len(p.PathInSchema) != len(other.PathInSchema) {
-		return false
-	}
-	for i, _tgt := range p.PathInSchema {
-		_src5 := other.PathInSchema[i]
-		if _tgt != _src5 {
-			return false
-		}
-	}
-	if p.Codec != other.Codec {
-		return false
-	}
-	if p.NumValues != other.NumValues {
-		return false
-	}
-	if p.TotalUncompressedSize != other.TotalUncompressedSize {
-		return false
-	}
-	if p.TotalCompressedSize != other.TotalCompressedSize {
-		return false
-	}
-	if len(p.KeyValueMetadata) != len(other.KeyValueMetadata) {
+	if len(p.Columns) != len(other.Columns) {
 		return false
 	}
-	for i, _tgt := range p.KeyValueMetadata {
-		_src6 := other.KeyValueMetadata[i]
-		if !_tgt.Equals(_src6) {
+	for i, _tgt := range p.Columns {
+		_src10 := other.Columns[i]
+		if !_tgt.Equals(_src10) {
 			return false
 		}
 	}
-	if p.DataPageOffset != other.DataPageOffset {
+	if p.TotalByteSize != other.TotalByteSize {
 		return false
 	}
-	if p.IndexPageOffset != other.IndexPageOffset {
-		if p.IndexPageOffset == nil || other.IndexPageOffset == nil {
-			return false
-		}
-		if (*p.IndexPageOffset) != (*other.IndexPageOffset) {
-			return false
-		}
-	}
-	if p.DictionaryPageOffset != other.DictionaryPageOffset {
-		if p.DictionaryPageOffset == nil || other.DictionaryPageOffset == nil {
-			return false
-		}
-		if (*p.DictionaryPageOffset) != (*other.DictionaryPageOffset) {
-			return false
-		}
-	}
-	if !p.Statistics.Equals(other.Statistics) {
+	if p.NumRows != other.NumRows {
 		return false
 	}
-	if len(p.EncodingStats) != len(other.EncodingStats) {
+	if len(p.SortingColumns) != len(other.SortingColumns) {
 		return false
 	}
-	for i, _tgt := range p.EncodingStats {
-		_src7 := other.EncodingStats[i]
-		if !_tgt.Equals(_src7) {
+	for i, _tgt := range p.SortingColumns {
+		_src11 := other.SortingColumns[i]
+		if !_tgt.Equals(_src11) {
 			return false
 		}
 	}
 	return true
 }
 
-func (p *ColumnMetaData) String() string {
+func (p *RowGroup) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnMetaData(%+v)", *p)
+	return fmt.Sprintf("RowGroup(%+v)", *p)
 }
 
-// Attributes:
-//  - FilePath: File where column data is stored.  If not set, assumed to be same file as
-// metadata.  This path is relative to the current file.
-//
-//  - FileOffset: Byte offset in file_path to the ColumnMetaData *
-//  - MetaData: Column metadata for this chunk. This is the same content as what is at
-// file_path/file_offset.  Having it here has it replicated in the file
-// metadata.
-//
-//  - OffsetIndexOffset: File offset of ColumnChunk's OffsetIndex *
-//  - OffsetIndexLength: Size of ColumnChunk's OffsetIndex, in bytes *
-//  - ColumnIndexOffset: File offset of ColumnChunk's ColumnIndex *
-//  - ColumnIndexLength: Size of ColumnChunk's ColumnIndex, in bytes *
-type ColumnChunk struct {
-	FilePath          *string         `thrift:"file_path,1" db:"file_path" json:"file_path,omitempty"`
-	FileOffset        int64           `thrift:"file_offset,2,required" db:"file_offset" json:"file_offset"`
-	MetaData          *ColumnMetaData `thrift:"meta_data,3" db:"meta_data" json:"meta_data,omitempty"`
-	OffsetIndexOffset *int64          `thrift:"offset_index_offset,4" db:"offset_index_offset" json:"offset_index_offset,omitempty"`
-	OffsetIndexLength *int32          `thrift:"offset_index_length,5" db:"offset_index_length" json:"offset_index_length,omitempty"`
-	ColumnIndexOffset *int64          `thrift:"column_index_offset,6" db:"column_index_offset" json:"column_index_offset,omitempty"`
-	ColumnIndexLength *int32          `thrift:"column_index_length,7" db:"column_index_length" json:"column_index_length,omitempty"`
+// Empty struct to signal the order defined by the physical or logical type
+type TypeDefinedOrder struct {
 }
 
-func NewColumnChunk() *ColumnChunk {
-	return &ColumnChunk{}
+func NewTypeDefinedOrder() *TypeDefinedOrder {
+	return &TypeDefinedOrder{}
 }
 
-var ColumnChunk_FilePath_DEFAULT string
-
-func (p *ColumnChunk) GetFilePath() string {
-	if !p.IsSetFilePath() {
-		return ColumnChunk_FilePath_DEFAULT
+func (p *TypeDefinedOrder) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	return *p.FilePath
-}
-
-func (p *ColumnChunk) GetFileOffset() int64 {
-	return p.FileOffset
-}
-
-var ColumnChunk_MetaData_DEFAULT *ColumnMetaData
 
-func (p *ColumnChunk) GetMetaData() *ColumnMetaData {
-	if !p.IsSetMetaData() {
-		return ColumnChunk_MetaData_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
-	return p.MetaData
-}
-
-var ColumnChunk_OffsetIndexOffset_DEFAULT int64
-
-func (p *ColumnChunk) GetOffsetIndexOffset() int64 {
-	if !p.IsSetOffsetIndexOffset() {
-		return ColumnChunk_OffsetIndexOffset_DEFAULT
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return *p.OffsetIndexOffset
+	return nil
 }
 
-var ColumnChunk_OffsetIndexLength_DEFAULT int32
-
-func (p *ColumnChunk) GetOffsetIndexLength() int32 {
-	if !p.IsSetOffsetIndexLength() {
-		return ColumnChunk_OffsetIndexLength_DEFAULT
+func (p *TypeDefinedOrder) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "TypeDefinedOrder"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return *p.OffsetIndexLength
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var ColumnChunk_ColumnIndexOffset_DEFAULT int64
-
-func (p *ColumnChunk) GetColumnIndexOffset() int64 {
-	if !p.IsSetColumnIndexOffset() {
-		return ColumnChunk_ColumnIndexOffset_DEFAULT
+func (p *TypeDefinedOrder) Equals(other *TypeDefinedOrder) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return *p.ColumnIndexOffset
+	return true
 }
 
-var ColumnChunk_ColumnIndexLength_DEFAULT int32
-
-func (p *ColumnChunk) GetColumnIndexLength() int32 {
-	if !p.IsSetColumnIndexLength() {
-		return ColumnChunk_ColumnIndexLength_DEFAULT
+func (p *TypeDefinedOrder) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.ColumnIndexLength
-}
-func (p *ColumnChunk) IsSetFilePath() bool {
-	return p.FilePath != nil
+	return fmt.Sprintf("TypeDefinedOrder(%+v)", *p)
 }
 
-func (p *ColumnChunk) IsSetMetaData() bool {
-	return p.MetaData != nil
+// Union to specify the order used for the min_value and max_value fields for a
+// column. This union takes the role of an enhanced enum that allows rich
+// elements (which will be needed for a collation-based ordering in the future).
+//
+// Possible values are:
+// * TypeDefinedOrder - the column uses the order defined by its logical or
+//                      physical type (if there is no logical type).
+//
+// If the reader does not support the value of this union, min and max stats
+// for this column should be ignored.
+//
+// Attributes:
+//  - TYPE_ORDER: The sort orders for logical types are:
+//   UTF8 - unsigned byte-wise comparison
+//   INT8 - signed comparison
+//   INT16 - signed comparison
+//   INT32 - signed comparison
+//   INT64 - signed comparison
+//   UINT8 - unsigned comparison
+//   UINT16 - unsigned comparison
+//   UINT32 - unsigned comparison
+//   UINT64 - unsigned comparison
+//   DECIMAL - signed comparison of the represented value
+//   DATE - signed comparison
+//   TIME_MILLIS - signed comparison
+//   TIME_MICROS - signed comparison
+//   TIMESTAMP_MILLIS - signed comparison
+//   TIMESTAMP_MICROS - signed comparison
+//   INTERVAL - unsigned comparison
+//   JSON - unsigned byte-wise comparison
+//   BSON - unsigned byte-wise comparison
+//   ENUM - unsigned byte-wise comparison
+//   LIST - undefined
+//   MAP - undefined
+//
+// In the absence of logical types, the sort order is determined by the physical type:
+//   BOOLEAN - false, true
+//   INT32 - signed comparison
+//   INT64 - signed comparison
+//   INT96 (only used for legacy timestamps) - undefined
+//   FLOAT - signed comparison of the represented value (*)
+//   DOUBLE - signed comparison of the represented value (*)
+//   BYTE_ARRAY - unsigned byte-wise comparison
+//   FIXED_LEN_BYTE_ARRAY - unsigned byte-wise comparison
+//
+// (*) Because the sorting order is not specified properly for floating
+//     point values (relations vs. total ordering) the following
+//     compatibility rules should be applied when reading statistics:
+//     - If the min is a NaN, it should be ignored.
+//     - If the max is a NaN, it should be ignored.
+//     - If the min is +0, the row group may contain -0 values as well.
+//     - If the max is -0, the row group may contain +0 values as well.
+//     - When looking for NaN values, min and max should be ignored.
+type ColumnOrder struct {
+	TYPE_ORDER *TypeDefinedOrder `thrift:"TYPE_ORDER,1" db:"TYPE_ORDER" json:"TYPE_ORDER,omitempty"`
 }
 
-func (p *ColumnChunk) IsSetOffsetIndexOffset() bool {
-	return p.OffsetIndexOffset != nil
+func NewColumnOrder() *ColumnOrder {
+	return &ColumnOrder{}
 }
 
-func (p *ColumnChunk) IsSetOffsetIndexLength() bool {
-	return p.OffsetIndexLength != nil
+var ColumnOrder_TYPE_ORDER_DEFAULT *TypeDefinedOrder
+
+func (p *ColumnOrder) GetTYPE_ORDER() *TypeDefinedOrder {
+	if !p.IsSetTYPE_ORDER() {
+		return ColumnOrder_TYPE_ORDER_DEFAULT
+	}
+	return p.TYPE_ORDER
 }
+func (p *ColumnOrder) CountSetFieldsColumnOrder() int {
+	count := 0
+	if p.IsSetTYPE_ORDER() {
+		count++
+	}
+	return count
 
-func (p *ColumnChunk) IsSetColumnIndexOffset() bool {
-	return p.ColumnIndexOffset != nil
 }
 
-func (p *ColumnChunk) IsSetColumnIndexLength() bool {
-	return p.ColumnIndexLength != nil
+func (p *ColumnOrder) IsSetTYPE_ORDER() bool {
+	return p.TYPE_ORDER != nil
 }
 
-func (p *ColumnChunk) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *ColumnOrder) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetFileOffset bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
 		if err != nil {
@@ -7470,69 +9834,8 @@ func (p *ColumnChunk) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err := p.ReadField1(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
-				issetFileOffset = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 3:
 			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField7(ctx, iprot); err != nil {
+				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
 			} else {
@@ -7541,111 +9844,39 @@ func (p *ColumnChunk) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				}
 			}
 		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(ctx); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	if !issetFileOffset {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FileOffset is not set"))
-	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.FilePath = &v
-	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.FileOffset = v
-	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	p.MetaData = &ColumnMetaData{}
-	if err := p.MetaData.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MetaData), err)
-	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.OffsetIndexOffset = &v
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
-	return nil
-}
-
-func (p *ColumnChunk) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.OffsetIndexLength = &v
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
 	return nil
 }
 
-func (p *ColumnChunk) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.ColumnIndexOffset = &v
+func (p *ColumnOrder) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.TYPE_ORDER = &TypeDefinedOrder{}
+	if err := p.TYPE_ORDER.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TYPE_ORDER), err)
 	}
 	return nil
 }
 
-func (p *ColumnChunk) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
-	} else {
-		p.ColumnIndexLength = &v
+func (p *ColumnOrder) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsColumnOrder(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set)", p, c)
 	}
-	return nil
-}
-
-func (p *ColumnChunk) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "ColumnChunk"); err != nil {
+	if err := oprot.WriteStructBegin(ctx, "ColumnOrder"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField7(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7656,219 +9887,75 @@ func (p *ColumnChunk) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnChunk) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilePath() {
-		if err := oprot.WriteFieldBegin(ctx, "file_path", thrift.STRING, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:file_path: ", p), err)
-		}
-		if err := oprot.WriteString(ctx, string(*p.FilePath)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.file_path (1) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:file_path: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *ColumnChunk) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "file_offset", thrift.I64, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:file_offset: ", p), err)
-	}
-	if err := oprot.WriteI64(ctx, int64(p.FileOffset)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.file_offset (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:file_offset: ", p), err)
-	}
-	return err
-}
-
-func (p *ColumnChunk) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetMetaData() {
-		if err := oprot.WriteFieldBegin(ctx, "meta_data", thrift.STRUCT, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:meta_data: ", p), err)
-		}
-		if err := p.MetaData.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MetaData), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:meta_data: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *ColumnChunk) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetOffsetIndexOffset() {
-		if err := oprot.WriteFieldBegin(ctx, "offset_index_offset", thrift.I64, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:offset_index_offset: ", p), err)
-		}
-		if err := oprot.WriteI64(ctx, int64(*p.OffsetIndexOffset)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.offset_index_offset (4) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:offset_index_offset: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *ColumnChunk) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetOffsetIndexLength() {
-		if err := oprot.WriteFieldBegin(ctx, "offset_index_length", thrift.I32, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:offset_index_length: ", p), err)
-		}
-		if err := oprot.WriteI32(ctx, int32(*p.OffsetIndexLength)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.offset_index_length (5) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:offset_index_length: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *ColumnChunk) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetColumnIndexOffset() {
-		if err := oprot.WriteFieldBegin(ctx, "column_index_offset", thrift.I64, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:column_index_offset: ", p), err)
-		}
-		if err := oprot.WriteI64(ctx, int64(*p.ColumnIndexOffset)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.column_index_offset (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:column_index_offset: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *ColumnChunk) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetColumnIndexLength() {
-		if err := oprot.WriteFieldBegin(ctx, "column_index_length", thrift.I32, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:column_index_length: ", p), err)
+func (p *ColumnOrder) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTYPE_ORDER() {
+		if err := oprot.WriteFieldBegin(ctx, "TYPE_ORDER", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:TYPE_ORDER: ", p), err)
 		}
-		if err := oprot.WriteI32(ctx, int32(*p.ColumnIndexLength)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.column_index_length (7) field write error: ", p), err)
+		if err := p.TYPE_ORDER.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TYPE_ORDER), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:column_index_length: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:TYPE_ORDER: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnChunk) Equals(other *ColumnChunk) bool {
+func (p *ColumnOrder) Equals(other *ColumnOrder) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.FilePath != other.FilePath {
-		if p.FilePath == nil || other.FilePath == nil {
-			return false
-		}
-		if (*p.FilePath) != (*other.FilePath) {
-			return false
-		}
-	}
-	if p.FileOffset != other.FileOffset {
-		return false
-	}
-	if !p.MetaData.Equals(other.MetaData) {
+	if !p.TYPE_ORDER.Equals(other.TYPE_ORDER) {
 		return false
 	}
-	if p.OffsetIndexOffset != other.OffsetIndexOffset {
-		if p.OffsetIndexOffset == nil || other.OffsetIndexOffset == nil {
-			return false
-		}
-		if (*p.OffsetIndexOffset) != (*other.OffsetIndexOffset) {
-			return false
-		}
-	}
-	if p.OffsetIndexLength != other.OffsetIndexLength {
-		if p.OffsetIndexLength == nil || other.OffsetIndexLength == nil {
-			return false
-		}
-		if (*p.OffsetIndexLength) != (*other.OffsetIndexLength) {
-			return false
-		}
-	}
-	if p.ColumnIndexOffset != other.ColumnIndexOffset {
-		if p.ColumnIndexOffset == nil || other.ColumnIndexOffset == nil {
-			return false
-		}
-		if (*p.ColumnIndexOffset) != (*other.ColumnIndexOffset) {
-			return false
-		}
-	}
-	if p.ColumnIndexLength != other.ColumnIndexLength {
-		if p.ColumnIndexLength == nil || other.ColumnIndexLength == nil {
-			return false
-		}
-		if (*p.ColumnIndexLength) != (*other.ColumnIndexLength) {
-			return false
-		}
-	}
 	return true
 }
 
-func (p *ColumnChunk) String() string {
+func (p *ColumnOrder) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnChunk(%+v)", *p)
+	return fmt.Sprintf("ColumnOrder(%+v)", *p)
 }
 
 // Attributes:
-//  - Columns: Metadata for each column chunk in this row group.
-// This list must have the same order as the SchemaElement list in FileMetaData.
-//
-//  - TotalByteSize: Total byte size of all the uncompressed column data in this row group *
-//  - NumRows: Number of rows in this row group *
-//  - SortingColumns: If set, specifies a sort ordering of the rows in this RowGroup.
-// The sorting columns can be a subset of all the columns.
-type RowGroup struct {
-	Columns        []*ColumnChunk   `thrift:"columns,1,required" db:"columns" json:"columns"`
-	TotalByteSize  int64            `thrift:"total_byte_size,2,required" db:"total_byte_size" json:"total_byte_size"`
-	NumRows        int64            `thrift:"num_rows,3,required" db:"num_rows" json:"num_rows"`
-	SortingColumns []*SortingColumn `thrift:"sorting_columns,4" db:"sorting_columns" json:"sorting_columns,omitempty"`
-}
-
-func NewRowGroup() *RowGroup {
-	return &RowGroup{}
-}
-
-func (p *RowGroup) GetColumns() []*ColumnChunk {
-	return p.Columns
+//  - Offset: Offset of the page in the file *
+//  - CompressedPageSize: Size of the page, including header. Sum of compressed_page_size and header
+// length
+//  - FirstRowIndex: Index within the RowGroup of the first row of the page; this means pages
+// change on record boundaries (r = 0).
+type PageLocation struct {
+	Offset             int64 `thrift:"offset,1,required" db:"offset" json:"offset"`
+	CompressedPageSize int32 `thrift:"compressed_page_size,2,required" db:"compressed_page_size" json:"compressed_page_size"`
+	FirstRowIndex      int64 `thrift:"first_row_index,3,required" db:"first_row_index" json:"first_row_index"`
 }
 
-func (p *RowGroup) GetTotalByteSize() int64 {
-	return p.TotalByteSize
+func NewPageLocation() *PageLocation {
+	return &PageLocation{}
 }
 
-func (p *RowGroup) GetNumRows() int64 {
-	return p.NumRows
+func (p *PageLocation) GetOffset() int64 {
+	return p.Offset
 }
 
-var RowGroup_SortingColumns_DEFAULT []*SortingColumn
-
-func (p *RowGroup) GetSortingColumns() []*SortingColumn {
-	return p.SortingColumns
-}
-func (p *RowGroup) IsSetSortingColumns() bool {
-	return p.SortingColumns != nil
+func (p *PageLocation) GetCompressedPageSize() int32 {
+	return p.CompressedPageSize
 }
 
-func (p *RowGroup) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *PageLocation) GetFirstRowIndex() int64 {
+	return p.FirstRowIndex
+}
+func (p *PageLocation) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetColumns bool = false
-	var issetTotalByteSize bool = false
-	var issetNumRows bool = false
+	var issetOffset bool = false
+	var issetCompressedPageSize bool = false
+	var issetFirstRowIndex bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
@@ -7880,22 +9967,22 @@ func (p *RowGroup) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
-				issetColumns = true
+				issetOffset = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
-				issetTotalByteSize = true
+				issetCompressedPageSize = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -7906,17 +9993,7 @@ func (p *RowGroup) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
-				issetNumRows = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
+				issetFirstRowIndex = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -7934,78 +10011,47 @@ func (p *RowGroup) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetColumns {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Columns is not set"))
+	if !issetOffset {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Offset is not set"))
 	}
-	if !issetTotalByteSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalByteSize is not set"))
+	if !issetCompressedPageSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
 	}
-	if !issetNumRows {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
+	if !issetFirstRowIndex {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FirstRowIndex is not set"))
 	}
 	return nil
 }
 
-func (p *RowGroup) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*ColumnChunk, 0, size)
-	p.Columns = tSlice
-	for i := 0; i < size; i++ {
-		_elem8 := &ColumnChunk{}
-		if err := _elem8.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
-		}
-		p.Columns = append(p.Columns, _elem8)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *PageLocation) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Offset = v
 	}
 	return nil
 }
 
-func (p *RowGroup) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *PageLocation) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.TotalByteSize = v
+		p.CompressedPageSize = v
 	}
 	return nil
 }
 
-func (p *RowGroup) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *PageLocation) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.NumRows = v
-	}
-	return nil
-}
-
-func (p *RowGroup) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*SortingColumn, 0, size)
-	p.SortingColumns = tSlice
-	for i := 0; i < size; i++ {
-		_elem9 := &SortingColumn{}
-		if err := _elem9.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem9), err)
-		}
-		p.SortingColumns = append(p.SortingColumns, _elem9)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+		p.FirstRowIndex = v
 	}
 	return nil
 }
 
-func (p *RowGroup) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "RowGroup"); err != nil {
+func (p *PageLocation) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PageLocation"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -8018,9 +10064,6 @@ func (p *RowGroup) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8031,129 +10074,91 @@ func (p *RowGroup) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *RowGroup) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "columns", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:columns: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Columns)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Columns {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+func (p *PageLocation) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "offset", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:offset: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteI64(ctx, int64(p.Offset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.offset (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:columns: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:offset: ", p), err)
 	}
 	return err
 }
 
-func (p *RowGroup) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "total_byte_size", thrift.I64, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:total_byte_size: ", p), err)
+func (p *PageLocation) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "compressed_page_size", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compressed_page_size: ", p), err)
 	}
-	if err := oprot.WriteI64(ctx, int64(p.TotalByteSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.total_byte_size (2) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.CompressedPageSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:total_byte_size: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compressed_page_size: ", p), err)
 	}
 	return err
 }
 
-func (p *RowGroup) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "num_rows", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
+func (p *PageLocation) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "first_row_index", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first_row_index: ", p), err)
 	}
-	if err := oprot.WriteI64(ctx, int64(p.NumRows)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
+	if err := oprot.WriteI64(ctx, int64(p.FirstRowIndex)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.first_row_index (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
-	}
-	return err
-}
-
-func (p *RowGroup) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetSortingColumns() {
-		if err := oprot.WriteFieldBegin(ctx, "sorting_columns", thrift.LIST, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:sorting_columns: ", p), err)
-		}
-		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.SortingColumns)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.SortingColumns {
-			if err := v.Write(ctx, oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
-		}
-		if err := oprot.WriteListEnd(ctx); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:sorting_columns: ", p), err)
-		}
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first_row_index: ", p), err)
 	}
 	return err
 }
 
-func (p *RowGroup) Equals(other *RowGroup) bool {
+func (p *PageLocation) Equals(other *PageLocation) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if len(p.Columns) != len(other.Columns) {
-		return false
-	}
-	for i, _tgt := range p.Columns {
-		_src10 := other.Columns[i]
-		if !_tgt.Equals(_src10) {
-			return false
-		}
-	}
-	if p.TotalByteSize != other.TotalByteSize {
+	if p.Offset != other.Offset {
 		return false
 	}
-	if p.NumRows != other.NumRows {
+	if p.CompressedPageSize != other.CompressedPageSize {
 		return false
 	}
-	if len(p.SortingColumns) != len(other.SortingColumns) {
+	if p.FirstRowIndex != other.FirstRowIndex {
 		return false
 	}
-	for i, _tgt := range p.SortingColumns {
-		_src11 := other.SortingColumns[i]
-		if !_tgt.Equals(_src11) {
-			return false
-		}
-	}
 	return true
 }
 
-func (p *RowGroup) String() string {
+func (p *PageLocation) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RowGroup(%+v)", *p)
+	return fmt.Sprintf("PageLocation(%+v)", *p)
 }
 
-// Empty struct to signal the order defined by the physical or logical type
-type TypeDefinedOrder struct {
+// Attributes:
+//  - PageLocations: PageLocations, ordered by increasing PageLocation.offset. It is required
+// that page_locations[i].first_row_index < page_locations[i+1].first_row_index.
+type OffsetIndex struct {
+	PageLocations []*PageLocation `thrift:"page_locations,1,required" db:"page_locations" json:"page_locations"`
 }
 
-func NewTypeDefinedOrder() *TypeDefinedOrder {
-	return &TypeDefinedOrder{}
+func NewOffsetIndex() *OffsetIndex {
+	return &OffsetIndex{}
 }
 
-func (p *TypeDefinedOrder) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *OffsetIndex) GetPageLocations() []*PageLocation {
+	return p.PageLocations
+}
+func (p *OffsetIndex) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
+	var issetPageLocations bool = false
+
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
 		if err != nil {
@@ -8162,8 +10167,22 @@ func (p *TypeDefinedOrder) Read(ctx context.Context, iprot thrift.TProtocol) err
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-			return err
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+				issetPageLocations = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
 		}
 		if err := iprot.ReadFieldEnd(ctx); err != nil {
 			return err
@@ -8172,14 +10191,40 @@ func (p *TypeDefinedOrder) Read(ctx context.Context, iprot thrift.TProtocol) err
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
+	if !issetPageLocations {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageLocations is not set"))
+	}
 	return nil
 }
 
-func (p *TypeDefinedOrder) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "TypeDefinedOrder"); err != nil {
+func (p *OffsetIndex) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*PageLocation, 0, size)
+	p.PageLocations = tSlice
+	for i := 0; i < size; i++ {
+		_elem12 := &PageLocation{}
+		if err := _elem12.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem12), err)
+		}
+		p.PageLocations = append(p.PageLocations, _elem12)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *OffsetIndex) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "OffsetIndex"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8190,109 +10235,121 @@ func (p *TypeDefinedOrder) Write(ctx context.Context, oprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *TypeDefinedOrder) Equals(other *TypeDefinedOrder) bool {
+func (p *OffsetIndex) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "page_locations", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_locations: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.PageLocations)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.PageLocations {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_locations: ", p), err)
+	}
+	return err
+}
+
+func (p *OffsetIndex) Equals(other *OffsetIndex) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
+	if len(p.PageLocations) != len(other.PageLocations) {
+		return false
+	}
+	for i, _tgt := range p.PageLocations {
+		_src13 := other.PageLocations[i]
+		if !_tgt.Equals(_src13) {
+			return false
+		}
+	}
 	return true
 }
 
-func (p *TypeDefinedOrder) String() string {
+func (p *OffsetIndex) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TypeDefinedOrder(%+v)", *p)
+	return fmt.Sprintf("OffsetIndex(%+v)", *p)
 }
 
-// Union to specify the order used for the min_value and max_value fields for a
-// column. This union takes the role of an enhanced enum that allows rich
-// elements (which will be needed for a collation-based ordering in the future).
-//
-// Possible values are:
-// * TypeDefinedOrder - the column uses the order defined by its logical or
-//                      physical type (if there is no logical type).
-//
-// If the reader does not support the value of this union, min and max stats
-// for this column should be ignored.
+// Description for ColumnIndex.
+// Each <array-field>[i] refers to the page at OffsetIndex.page_locations[i]
 //
 // Attributes:
-//  - TYPE_ORDER: The sort orders for logical types are:
-//   UTF8 - unsigned byte-wise comparison
-//   INT8 - signed comparison
-//   INT16 - signed comparison
-//   INT32 - signed comparison
-//   INT64 - signed comparison
-//   UINT8 - unsigned comparison
-//   UINT16 - unsigned comparison
-//   UINT32 - unsigned comparison
-//   UINT64 - unsigned comparison
-//   DECIMAL - signed comparison of the represented value
-//   DATE - signed comparison
-//   TIME_MILLIS - signed comparison
-//   TIME_MICROS - signed comparison
-//   TIMESTAMP_MILLIS - signed comparison
-//   TIMESTAMP_MICROS - signed comparison
-//   INTERVAL - unsigned comparison
-//   JSON - unsigned byte-wise comparison
-//   BSON - unsigned byte-wise comparison
-//   ENUM - unsigned byte-wise comparison
-//   LIST - undefined
-//   MAP - undefined
-//
-// In the absence of logical types, the sort order is determined by the physical type:
-//   BOOLEAN - false, true
-//   INT32 - signed comparison
-//   INT64 - signed comparison
-//   INT96 (only used for legacy timestamps) - undefined
-//   FLOAT - signed comparison of the represented value (*)
-//   DOUBLE - signed comparison of the represented value (*)
-//   BYTE_ARRAY - unsigned byte-wise comparison
-//   FIXED_LEN_BYTE_ARRAY - unsigned byte-wise comparison
-//
-// (*) Because the sorting order is not specified properly for floating
-//     point values (relations vs. total ordering) the following
-//     compatibility rules should be applied when reading statistics:
-//     - If the min is a NaN, it should be ignored.
-//     - If the max is a NaN, it should be ignored.
-//     - If the min is +0, the row group may contain -0 values as well.
-//     - If the max is -0, the row group may contain +0 values as well.
-//     - When looking for NaN values, min and max should be ignored.
-type ColumnOrder struct {
-	TYPE_ORDER *TypeDefinedOrder `thrift:"TYPE_ORDER,1" db:"TYPE_ORDER" json:"TYPE_ORDER,omitempty"`
+//  - NullPages: A list of Boolean values to determine the validity of the corresponding
+// min and max values. If true, a page contains only null values, and writers
+// have to set the corresponding entries in min_values and max_values to
+// byte[0], so that all lists have the same length. If false, the
+// corresponding entries in min_values and max_values must be valid.
+//  - MinValues: Two lists containing lower and upper bounds for the values of each page.
+// These may be the actual minimum and maximum values found on a page, but
+// can also be (more compact) values that do not exist on a page. For
+// example, instead of storing ""Blart Versenwald III", a writer may set
+// min_values[i]="B", max_values[i]="C". Such more compact values must still
+// be valid values within the column's logical type. Readers must make sure
+// that list entries are populated before using them by inspecting null_pages.
+//  - MaxValues
+//  - BoundaryOrder: Stores whether both min_values and max_values are orderd and if so, in
+// which direction. This allows readers to perform binary searches in both
+// lists. Readers cannot assume that max_values[i] <= min_values[i+1], even
+// if the lists are ordered.
+//  - NullCounts: A list containing the number of null values for each page *
+type ColumnIndex struct {
+	NullPages     []bool        `thrift:"null_pages,1,required" db:"null_pages" json:"null_pages"`
+	MinValues     [][]byte      `thrift:"min_values,2,required" db:"min_values" json:"min_values"`
+	MaxValues     [][]byte      `thrift:"max_values,3,required" db:"max_values" json:"max_values"`
+	BoundaryOrder BoundaryOrder `thrift:"boundary_order,4,required" db:"boundary_order" json:"boundary_order"`
+	NullCounts    []int64       `thrift:"null_counts,5" db:"null_counts" json:"null_counts,omitempty"`
 }
 
-func NewColumnOrder() *ColumnOrder {
-	return &ColumnOrder{}
+func NewColumnIndex() *ColumnIndex {
+	return &ColumnIndex{}
 }
 
-var ColumnOrder_TYPE_ORDER_DEFAULT *TypeDefinedOrder
+func (p *ColumnIndex) GetNullPages() []bool {
+	return p.NullPages
+}
 
-func (p *ColumnOrder) GetTYPE_ORDER() *TypeDefinedOrder {
-	if !p.IsSetTYPE_ORDER() {
-		return ColumnOrder_TYPE_ORDER_DEFAULT
-	}
-	return p.TYPE_ORDER
+func (p *ColumnIndex) GetMinValues() [][]byte {
+	return p.MinValues
+}
+
+func (p *ColumnIndex) GetMaxValues() [][]byte {
+	return p.MaxValues
 }
-func (p *ColumnOrder) CountSetFieldsColumnOrder() int {
-	count := 0
-	if p.IsSetTYPE_ORDER() {
-		count++
-	}
-	return count
 
+func (p *ColumnIndex) GetBoundaryOrder() BoundaryOrder {
+	return p.BoundaryOrder
 }
 
-func (p *ColumnOrder) IsSetTYPE_ORDER() bool {
-	return p.TYPE_ORDER != nil
+var ColumnIndex_NullCounts_DEFAULT []int64
+
+func (p *ColumnIndex) GetNullCounts() []int64 {
+	return p.NullCounts
+}
+func (p *ColumnIndex) IsSetNullCounts() bool {
+	return p.NullCounts != nil
 }
 
-func (p *ColumnOrder) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *ColumnIndex) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
+	var issetNullPages bool = false
+	var issetMinValues bool = false
+	var issetMaxValues bool = false
+	var issetBoundaryOrder bool = false
+
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
 		if err != nil {
@@ -8303,10 +10360,54 @@ func (p *ColumnOrder) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
+				issetNullPages = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+				issetMinValues = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+				issetMaxValues = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+				issetBoundaryOrder = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -8317,35 +10418,146 @@ func (p *ColumnOrder) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				return err
 			}
 		}
-		if err := iprot.ReadFieldEnd(ctx); err != nil {
-			return err
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNullPages {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullPages is not set"))
+	}
+	if !issetMinValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MinValues is not set"))
+	}
+	if !issetMaxValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MaxValues is not set"))
+	}
+	if !issetBoundaryOrder {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field BoundaryOrder is not set"))
+	}
+	return nil
+}
+
+func (p *ColumnIndex) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]bool, 0, size)
+	p.NullPages = tSlice
+	for i := 0; i < size; i++ {
+		var _elem14 bool
+		if v, err := iprot.ReadBool(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem14 = v
+		}
+		p.NullPages = append(p.NullPages, _elem14)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([][]byte, 0, size)
+	p.MinValues = tSlice
+	for i := 0; i < size; i++ {
+		var _elem15 []byte
+		if v, err := iprot.ReadBinary(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem15 = v
+		}
+		p.MinValues = append(p.MinValues, _elem15)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([][]byte, 0, size)
+	p.MaxValues = tSlice
+	for i := 0; i < size; i++ {
+		var _elem16 []byte
+		if v, err := iprot.ReadBinary(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem16 = v
 		}
+		p.MaxValues = append(p.MaxValues, _elem16)
 	}
-	if err := iprot.ReadStructEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *ColumnOrder) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.TYPE_ORDER = &TypeDefinedOrder{}
-	if err := p.TYPE_ORDER.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TYPE_ORDER), err)
+func (p *ColumnIndex) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := BoundaryOrder(v)
+		p.BoundaryOrder = temp
 	}
 	return nil
 }
 
-func (p *ColumnOrder) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if c := p.CountSetFieldsColumnOrder(); c != 1 {
-		return fmt.Errorf("%T write union: exactly one field must be set (%d set)", p, c)
+func (p *ColumnIndex) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := oprot.WriteStructBegin(ctx, "ColumnOrder"); err != nil {
+	tSlice := make([]int64, 0, size)
+	p.NullCounts = tSlice
+	for i := 0; i < size; i++ {
+		var _elem17 int64
+		if v, err := iprot.ReadI64(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem17 = v
+		}
+		p.NullCounts = append(p.NullCounts, _elem17)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ColumnIndex"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8356,76 +10568,212 @@ func (p *ColumnOrder) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnOrder) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetTYPE_ORDER() {
-		if err := oprot.WriteFieldBegin(ctx, "TYPE_ORDER", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:TYPE_ORDER: ", p), err)
+func (p *ColumnIndex) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "null_pages", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:null_pages: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.BOOL, len(p.NullPages)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.NullPages {
+		if err := oprot.WriteBool(ctx, bool(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
-		if err := p.TYPE_ORDER.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TYPE_ORDER), err)
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:null_pages: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "min_values", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:min_values: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.MinValues)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MinValues {
+		if err := oprot.WriteBinary(ctx, v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:min_values: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "max_values", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:max_values: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.MaxValues)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MaxValues {
+		if err := oprot.WriteBinary(ctx, v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:max_values: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "boundary_order", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:boundary_order: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.BoundaryOrder)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.boundary_order (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:boundary_order: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetNullCounts() {
+		if err := oprot.WriteFieldBegin(ctx, "null_counts", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:null_counts: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.NullCounts)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.NullCounts {
+			if err := oprot.WriteI64(ctx, int64(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:TYPE_ORDER: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:null_counts: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnOrder) Equals(other *ColumnOrder) bool {
+func (p *ColumnIndex) Equals(other *ColumnIndex) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.TYPE_ORDER.Equals(other.TYPE_ORDER) {
+	if len(p.NullPages) != len(other.NullPages) {
+		return false
+	}
+	for i, _tgt := range p.NullPages {
+		_src18 := other.NullPages[i]
+		if _tgt != _src18 {
+			return false
+		}
+	}
+	if len(p.MinValues) != len(other.MinValues) {
+		return false
+	}
+	for i, _tgt := range p.MinValues {
+		_src19 := other.MinValues[i]
+		if bytes.Compare(_tgt, _src19) != 0 {
+			return false
+		}
+	}
+	if len(p.MaxValues) != len(other.MaxValues) {
+		return false
+	}
+	for i, _tgt := range p.MaxValues {
+		_src20 := other.MaxValues[i]
+		if bytes.Compare(_tgt, _src20) != 0 {
+			return false
+		}
+	}
+	if p.BoundaryOrder != other.BoundaryOrder {
+		return false
+	}
+	if len(p.NullCounts) != len(other.NullCounts) {
 		return false
 	}
+	for i, _tgt := range p.NullCounts {
+		_src21 := other.NullCounts[i]
+		if _tgt != _src21 {
+			return false
+		}
+	}
 	return true
 }
 
-func (p *ColumnOrder) String() string {
+func (p *ColumnIndex) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnOrder(%+v)", *p)
+	return fmt.Sprintf("ColumnIndex(%+v)", *p)
 }
 
 // Attributes:
-//  - Offset: Offset of the page in the file *
-//  - CompressedPageSize: Size of the page, including header. Sum of compressed_page_size and header
-// length
-//  - FirstRowIndex: Index within the RowGroup of the first row of the page; this means pages
-// change on record boundaries (r = 0).
-type PageLocation struct {
-	Offset             int64 `thrift:"offset,1,required" db:"offset" json:"offset"`
-	CompressedPageSize int32 `thrift:"compressed_page_size,2,required" db:"compressed_page_size" json:"compressed_page_size"`
-	FirstRowIndex      int64 `thrift:"first_row_index,3,required" db:"first_row_index" json:"first_row_index"`
+//  - AadPrefix: AAD prefix *
+//  - AadFileUnique: Unique file identifier part of AAD suffix *
+//  - SupplyAadPrefix: In files encrypted with AAD prefix without storing it,
+// readers must supply the prefix *
+type AesGcmV1 struct {
+	AadPrefix       []byte `thrift:"aad_prefix,1" db:"aad_prefix" json:"aad_prefix,omitempty"`
+	AadFileUnique   []byte `thrift:"aad_file_unique,2" db:"aad_file_unique" json:"aad_file_unique,omitempty"`
+	SupplyAadPrefix *bool  `thrift:"supply_aad_prefix,3" db:"supply_aad_prefix" json:"supply_aad_prefix,omitempty"`
 }
 
-func NewPageLocation() *PageLocation {
-	return &PageLocation{}
+func NewAesGcmV1() *AesGcmV1 {
+	return &AesGcmV1{}
 }
 
-func (p *PageLocation) GetOffset() int64 {
-	return p.Offset
+var AesGcmV1_AadPrefix_DEFAULT []byte
+
+func (p *AesGcmV1) GetAadPrefix() []byte {
+	return p.AadPrefix
 }
 
-func (p *PageLocation) GetCompressedPageSize() int32 {
-	return p.CompressedPageSize
+var AesGcmV1_AadFileUnique_DEFAULT []byte
+
+func (p *AesGcmV1) GetAadFileUnique() []byte {
+	return p.AadFileUnique
 }
 
-func (p *PageLocation) GetFirstRowIndex() int64 {
-	return p.FirstRowIndex
+var AesGcmV1_SupplyAadPrefix_DEFAULT bool
+
+func (p *AesGcmV1) GetSupplyAadPrefix() bool {
+	if !p.IsSetSupplyAadPrefix() {
+		return AesGcmV1_SupplyAadPrefix_DEFAULT
+	}
+	return *p.SupplyAadPrefix
+}
+func (p *AesGcmV1) IsSetAadPrefix() bool {
+	return p.AadPrefix != nil
+}
+
+func (p *AesGcmV1) IsSetAadFileUnique() bool {
+	return p.AadFileUnique != nil
+}
+
+func (p *AesGcmV1) IsSetSupplyAadPrefix() bool {
+	return p.SupplyAadPrefix != nil
 }
-func (p *PageLocation) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *AesGcmV1) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetOffset bool = false
-	var issetCompressedPageSize bool = false
-	var issetFirstRowIndex bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
 		if err != nil {
@@ -8436,33 +10784,30 @@ func (p *PageLocation) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
-				issetOffset = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
-				issetCompressedPageSize = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
-				issetFirstRowIndex = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -8480,47 +10825,38 @@ func (p *PageLocation) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetOffset {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Offset is not set"))
-	}
-	if !issetCompressedPageSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
-	}
-	if !issetFirstRowIndex {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FirstRowIndex is not set"))
-	}
 	return nil
 }
 
-func (p *PageLocation) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *AesGcmV1) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Offset = v
+		p.AadPrefix = v
 	}
 	return nil
 }
 
-func (p *PageLocation) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *AesGcmV1) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.CompressedPageSize = v
+		p.AadFileUnique = v
 	}
 	return nil
 }
 
-func (p *PageLocation) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *AesGcmV1) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.FirstRowIndex = v
+		p.SupplyAadPrefix = &v
 	}
 	return nil
 }
 
-func (p *PageLocation) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "PageLocation"); err != nil {
+func (p *AesGcmV1) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AesGcmV1"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -8543,91 +10879,134 @@ func (p *PageLocation) Write(ctx context.Context, oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *PageLocation) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "offset", thrift.I64, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:offset: ", p), err)
-	}
-	if err := oprot.WriteI64(ctx, int64(p.Offset)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.offset (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:offset: ", p), err)
+func (p *AesGcmV1) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadPrefix() {
+		if err := oprot.WriteFieldBegin(ctx, "aad_prefix", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:aad_prefix: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.AadPrefix); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_prefix (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:aad_prefix: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *PageLocation) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "compressed_page_size", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compressed_page_size: ", p), err)
-	}
-	if err := oprot.WriteI32(ctx, int32(p.CompressedPageSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compressed_page_size: ", p), err)
+func (p *AesGcmV1) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadFileUnique() {
+		if err := oprot.WriteFieldBegin(ctx, "aad_file_unique", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:aad_file_unique: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.AadFileUnique); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_file_unique (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:aad_file_unique: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *PageLocation) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "first_row_index", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first_row_index: ", p), err)
-	}
-	if err := oprot.WriteI64(ctx, int64(p.FirstRowIndex)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.first_row_index (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first_row_index: ", p), err)
+func (p *AesGcmV1) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSupplyAadPrefix() {
+		if err := oprot.WriteFieldBegin(ctx, "supply_aad_prefix", thrift.BOOL, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:supply_aad_prefix: ", p), err)
+		}
+		if err := oprot.WriteBool(ctx, bool(*p.SupplyAadPrefix)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.supply_aad_prefix (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:supply_aad_prefix: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *PageLocation) Equals(other *PageLocation) bool {
+func (p *AesGcmV1) Equals(other *AesGcmV1) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.Offset != other.Offset {
+	if bytes.Compare(p.AadPrefix, other.AadPrefix) != 0 {
 		return false
 	}
-	if p.CompressedPageSize != other.CompressedPageSize {
+	if bytes.Compare(p.AadFileUnique, other.AadFileUnique) != 0 {
 		return false
 	}
-	if p.FirstRowIndex != other.FirstRowIndex {
-		return false
+	if p.SupplyAadPrefix != other.SupplyAadPrefix {
+		if p.SupplyAadPrefix == nil || other.SupplyAadPrefix == nil {
+			return false
+		}
+		if (*p.SupplyAadPrefix) != (*other.SupplyAadPrefix) {
+			return false
+		}
 	}
 	return true
 }
 
-func (p *PageLocation) String() string {
+func (p *AesGcmV1) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PageLocation(%+v)", *p)
+	return fmt.Sprintf("AesGcmV1(%+v)", *p)
 }
 
+
 // Attributes:
-//  - PageLocations: PageLocations, ordered by increasing PageLocation.offset. It is required
-// that page_locations[i].first_row_index < page_locations[i+1].first_row_index.
-type OffsetIndex struct {
-	PageLocations []*PageLocation `thrift:"page_locations,1,required" db:"page_locations" json:"page_locations"`
+//  - AadPrefix: AAD prefix *
+//  - AadFileUnique: Unique file identifier part of AAD suffix *
+//  - SupplyAadPrefix: In files encrypted with AAD prefix without storing it,
+// readers must supply the prefix *
+type AesGcmCtrV1 struct {
+	AadPrefix       []byte `thrift:"aad_prefix,1" db:"aad_prefix" json:"aad_prefix,omitempty"`
+	AadFileUnique   []byte `thrift:"aad_file_unique,2" db:"aad_file_unique" json:"aad_file_unique,omitempty"`
+	SupplyAadPrefix *bool  `thrift:"supply_aad_prefix,3" db:"supply_aad_prefix" json:"supply_aad_prefix,omitempty"`
 }
 
-func NewOffsetIndex() *OffsetIndex {
-	return &OffsetIndex{}
+func NewAesGcmCtrV1() *AesGcmCtrV1 {
+	return &AesGcmCtrV1{}
 }
 
-func (p *OffsetIndex) GetPageLocations() []*PageLocation {
-	return p.PageLocations
+var AesGcmCtrV1_AadPrefix_DEFAULT []byte
+
+func (p *AesGcmCtrV1) GetAadPrefix() []byte {
+	return p.AadPrefix
 }
-func (p *OffsetIndex) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+var AesGcmCtrV1_AadFileUnique_DEFAULT []byte
+
+func (p *AesGcmCtrV1) GetAadFileUnique() []byte {
+	return p.AadFileUnique
+}
+
+var AesGcmCtrV1_SupplyAadPrefix_DEFAULT bool
+
+func (p *AesGcmCtrV1) GetSupplyAadPrefix() bool {
+	if !p.IsSetSupplyAadPrefix() {
+		return AesGcmCtrV1_SupplyAadPrefix_DEFAULT
+	}
+	return *p.SupplyAadPrefix
+}
+func (p *AesGcmCtrV1) IsSetAadPrefix() bool {
+	return p.AadPrefix != nil
+}
+
+func (p *AesGcmCtrV1) IsSetAadFileUnique() bool {
+	return p.AadFileUnique != nil
+}
+
+func (p *AesGcmCtrV1) IsSetSupplyAadPrefix() bool {
+	return p.SupplyAadPrefix != nil
+}
+
+func (p *AesGcmCtrV1) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetPageLocations bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
 		if err != nil {
@@ -8638,11 +11017,30 @@ func (p *OffsetIndex) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
-				issetPageLocations = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -8660,40 +11058,50 @@ func (p *OffsetIndex) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetPageLocations {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageLocations is not set"))
-	}
 	return nil
 }
 
-func (p *OffsetIndex) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *AesGcmCtrV1) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.AadPrefix = v
 	}
-	tSlice := make([]*PageLocation, 0, size)
-	p.PageLocations = tSlice
-	for i := 0; i < size; i++ {
-		_elem12 := &PageLocation{}
-		if err := _elem12.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem12), err)
-		}
-		p.PageLocations = append(p.PageLocations, _elem12)
+	return nil
+}
+
+func (p *AesGcmCtrV1) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.AadFileUnique = v
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	return nil
+}
+
+func (p *AesGcmCtrV1) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.SupplyAadPrefix = &v
 	}
 	return nil
 }
 
-func (p *OffsetIndex) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "OffsetIndex"); err != nil {
+func (p *AesGcmCtrV1) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AesGcmCtrV1"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8704,121 +11112,136 @@ func (p *OffsetIndex) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *OffsetIndex) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "page_locations", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_locations: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.PageLocations)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.PageLocations {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+func (p *AesGcmCtrV1) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadPrefix() {
+		if err := oprot.WriteFieldBegin(ctx, "aad_prefix", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:aad_prefix: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.AadPrefix); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_prefix (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:aad_prefix: ", p), err)
 		}
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	return err
+}
+
+func (p *AesGcmCtrV1) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadFileUnique() {
+		if err := oprot.WriteFieldBegin(ctx, "aad_file_unique", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:aad_file_unique: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.AadFileUnique); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_file_unique (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:aad_file_unique: ", p), err)
+		}
 	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_locations: ", p), err)
+	return err
+}
+
+func (p *AesGcmCtrV1) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSupplyAadPrefix() {
+		if err := oprot.WriteFieldBegin(ctx, "supply_aad_prefix", thrift.BOOL, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:supply_aad_prefix: ", p), err)
+		}
+		if err := oprot.WriteBool(ctx, bool(*p.SupplyAadPrefix)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.supply_aad_prefix (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:supply_aad_prefix: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *OffsetIndex) Equals(other *OffsetIndex) bool {
+func (p *AesGcmCtrV1) Equals(other *AesGcmCtrV1) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if len(p.PageLocations) != len(other.PageLocations) {
+	if bytes.Compare(p.AadPrefix, other.AadPrefix) != 0 {
 		return false
 	}
-	for i, _tgt := range p.PageLocations {
-		_src13 := other.PageLocations[i]
-		if !_tgt.Equals(_src13) {
+	if bytes.Compare(p.AadFileUnique, other.AadFileUnique) != 0 {
+		return false
+	}
+	if p.SupplyAadPrefix != other.SupplyAadPrefix {
+		if p.SupplyAadPrefix == nil || other.SupplyAadPrefix == nil {
+			return false
+		}
+		if (*p.SupplyAadPrefix) != (*other.SupplyAadPrefix) {
 			return false
 		}
 	}
 	return true
 }
 
-func (p *OffsetIndex) String() string {
+func (p *AesGcmCtrV1) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OffsetIndex(%+v)", *p)
-}
-
-// Description for ColumnIndex.
-// Each <array-field>[i] refers to the page at OffsetIndex.page_locations[i]
-//
-// Attributes:
-//  - NullPages: A list of Boolean values to determine the validity of the corresponding
-// min and max values. If true, a page contains only null values, and writers
-// have to set the corresponding entries in min_values and max_values to
-// byte[0], so that all lists have the same length. If false, the
-// corresponding entries in min_values and max_values must be valid.
-//  - MinValues: Two lists containing lower and upper bounds for the values of each page.
-// These may be the actual minimum and maximum values found on a page, but
-// can also be (more compact) values that do not exist on a page. For
-// example, instead of storing ""Blart Versenwald III", a writer may set
-// min_values[i]="B", max_values[i]="C". Such more compact values must still
-// be valid values within the column's logical type. Readers must make sure
-// that list entries are populated before using them by inspecting null_pages.
-//  - MaxValues
-//  - BoundaryOrder: Stores whether both min_values and max_values are orderd and if so, in
-// which direction. This allows readers to perform binary searches in both
-// lists. Readers cannot assume that max_values[i] <= min_values[i+1], even
-// if the lists are ordered.
-//  - NullCounts: A list containing the number of null values for each page *
-type ColumnIndex struct {
-	NullPages     []bool        `thrift:"null_pages,1,required" db:"null_pages" json:"null_pages"`
-	MinValues     [][]byte      `thrift:"min_values,2,required" db:"min_values" json:"min_values"`
-	MaxValues     [][]byte      `thrift:"max_values,3,required" db:"max_values" json:"max_values"`
-	BoundaryOrder BoundaryOrder `thrift:"boundary_order,4,required" db:"boundary_order" json:"boundary_order"`
-	NullCounts    []int64       `thrift:"null_counts,5" db:"null_counts" json:"null_counts,omitempty"`
+	return fmt.Sprintf("AesGcmCtrV1(%+v)", *p)
 }
 
-func NewColumnIndex() *ColumnIndex {
-	return &ColumnIndex{}
-}
 
-func (p *ColumnIndex) GetNullPages() []bool {
-	return p.NullPages
+// Attributes:
+//  - AES_GCM_V1
+//  - AES_GCM_CTR_V1
+type EncryptionAlgorithm struct {
+	AES_GCM_V1     *AesGcmV1    `thrift:"AES_GCM_V1,1" db:"AES_GCM_V1" json:"AES_GCM_V1,omitempty"`
+	AES_GCM_CTR_V1 *AesGcmCtrV1 `thrift:"AES_GCM_CTR_V1,2" db:"AES_GCM_CTR_V1" json:"AES_GCM_CTR_V1,omitempty"`
 }
 
-func (p *ColumnIndex) GetMinValues() [][]byte {
-	return p.MinValues
+func NewEncryptionAlgorithm() *EncryptionAlgorithm {
+	return &EncryptionAlgorithm{}
 }
 
-func (p *ColumnIndex) GetMaxValues() [][]byte {
-	return p.MaxValues
+var EncryptionAlgorithm_AES_GCM_V1_DEFAULT *AesGcmV1
+
+func (p *EncryptionAlgorithm) GetAES_GCM_V1() *AesGcmV1 {
+	if !p.IsSetAES_GCM_V1() {
+		return EncryptionAlgorithm_AES_GCM_V1_DEFAULT
+	}
+	return p.AES_GCM_V1
 }
 
-func (p *ColumnIndex) GetBoundaryOrder() BoundaryOrder {
-	return p.BoundaryOrder
+var EncryptionAlgorithm_AES_GCM_CTR_V1_DEFAULT *AesGcmCtrV1
+
+func (p *EncryptionAlgorithm) GetAES_GCM_CTR_V1() *AesGcmCtrV1 {
+	if !p.IsSetAES_GCM_CTR_V1() {
+		return EncryptionAlgorithm_AES_GCM_CTR_V1_DEFAULT
+	}
+	return p.AES_GCM_CTR_V1
 }
+func (p *EncryptionAlgorithm) CountSetFieldsEncryptionAlgorithm() int {
+	count := 0
+	if p.IsSetAES_GCM_V1() {
+		count++
+	}
+	if p.IsSetAES_GCM_CTR_V1() {
+		count++
+	}
+	return count
 
-var ColumnIndex_NullCounts_DEFAULT []int64
+}
 
-func (p *ColumnIndex) GetNullCounts() []int64 {
-	return p.NullCounts
+func (p *EncryptionAlgorithm) IsSetAES_GCM_V1() bool {
+	return p.AES_GCM_V1 != nil
 }
-func (p *ColumnIndex) IsSetNullCounts() bool {
-	return p.NullCounts != nil
+
+func (p *EncryptionAlgorithm) IsSetAES_GCM_CTR_V1() bool {
+	return p.AES_GCM_CTR_V1 != nil
 }
 
-func (p *ColumnIndex) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *EncryptionAlgorithm) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNullPages bool = false
-	var issetMinValues bool = false
-	var issetMaxValues bool = false
-	var issetBoundaryOrder bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
 		if err != nil {
@@ -8829,54 +11252,20 @@ func (p *ColumnIndex) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
-				issetNullPages = true
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
-				issetMinValues = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-				issetMaxValues = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
-				issetBoundaryOrder = true
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
 			} else {
 				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 					return err
@@ -8894,121 +11283,30 @@ func (p *ColumnIndex) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNullPages {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullPages is not set"))
-	}
-	if !issetMinValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MinValues is not set"))
-	}
-	if !issetMaxValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MaxValues is not set"))
-	}
-	if !issetBoundaryOrder {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field BoundaryOrder is not set"))
-	}
-	return nil
-}
-
-func (p *ColumnIndex) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]bool, 0, size)
-	p.NullPages = tSlice
-	for i := 0; i < size; i++ {
-		var _elem14 bool
-		if v, err := iprot.ReadBool(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem14 = v
-		}
-		p.NullPages = append(p.NullPages, _elem14)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
-}
-
-func (p *ColumnIndex) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([][]byte, 0, size)
-	p.MinValues = tSlice
-	for i := 0; i < size; i++ {
-		var _elem15 []byte
-		if v, err := iprot.ReadBinary(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem15 = v
-		}
-		p.MinValues = append(p.MinValues, _elem15)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
 	return nil
 }
 
-func (p *ColumnIndex) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([][]byte, 0, size)
-	p.MaxValues = tSlice
-	for i := 0; i < size; i++ {
-		var _elem16 []byte
-		if v, err := iprot.ReadBinary(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem16 = v
-		}
-		p.MaxValues = append(p.MaxValues, _elem16)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *EncryptionAlgorithm) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.AES_GCM_V1 = &AesGcmV1{}
+	if err := p.AES_GCM_V1.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AES_GCM_V1), err)
 	}
 	return nil
 }
 
-func (p *ColumnIndex) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		temp := BoundaryOrder(v)
-		p.BoundaryOrder = temp
+func (p *EncryptionAlgorithm) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.AES_GCM_CTR_V1 = &AesGcmCtrV1{}
+	if err := p.AES_GCM_CTR_V1.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AES_GCM_CTR_V1), err)
 	}
 	return nil
 }
 
-func (p *ColumnIndex) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]int64, 0, size)
-	p.NullCounts = tSlice
-	for i := 0; i < size; i++ {
-		var _elem17 int64
-		if v, err := iprot.ReadI64(ctx); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem17 = v
-		}
-		p.NullCounts = append(p.NullCounts, _elem17)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *EncryptionAlgorithm) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsEncryptionAlgorithm(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set)", p, c)
 	}
-	return nil
-}
-
-func (p *ColumnIndex) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "ColumnIndex"); err != nil {
+	if err := oprot.WriteStructBegin(ctx, "EncryptionAlgorithm"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -9018,15 +11316,6 @@ func (p *ColumnIndex) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -9037,160 +11326,59 @@ func (p *ColumnIndex) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnIndex) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "null_pages", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:null_pages: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.BOOL, len(p.NullPages)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.NullPages {
-		if err := oprot.WriteBool(ctx, bool(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
-	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:null_pages: ", p), err)
-	}
-	return err
-}
-
-func (p *ColumnIndex) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "min_values", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:min_values: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.MinValues)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.MinValues {
-		if err := oprot.WriteBinary(ctx, v); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
-	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:min_values: ", p), err)
-	}
-	return err
-}
-
-func (p *ColumnIndex) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "max_values", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:max_values: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.MaxValues)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.MaxValues {
-		if err := oprot.WriteBinary(ctx, v); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
-	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:max_values: ", p), err)
-	}
-	return err
-}
-
-func (p *ColumnIndex) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "boundary_order", thrift.I32, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:boundary_order: ", p), err)
-	}
-	if err := oprot.WriteI32(ctx, int32(p.BoundaryOrder)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.boundary_order (4) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:boundary_order: ", p), err)
-	}
-	return err
-}
-
-func (p *ColumnIndex) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetNullCounts() {
-		if err := oprot.WriteFieldBegin(ctx, "null_counts", thrift.LIST, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:null_counts: ", p), err)
+func (p *EncryptionAlgorithm) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAES_GCM_V1() {
+		if err := oprot.WriteFieldBegin(ctx, "AES_GCM_V1", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AES_GCM_V1: ", p), err)
 		}
-		if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.NullCounts)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
+		if err := p.AES_GCM_V1.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AES_GCM_V1), err)
 		}
-		for _, v := range p.NullCounts {
-			if err := oprot.WriteI64(ctx, int64(v)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-			}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AES_GCM_V1: ", p), err)
 		}
-		if err := oprot.WriteListEnd(ctx); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
+	}
+	return err
+}
+
+func (p *EncryptionAlgorithm) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAES_GCM_CTR_V1() {
+		if err := oprot.WriteFieldBegin(ctx, "AES_GCM_CTR_V1", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AES_GCM_CTR_V1: ", p), err)
+		}
+		if err := p.AES_GCM_CTR_V1.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AES_GCM_CTR_V1), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:null_counts: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AES_GCM_CTR_V1: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnIndex) Equals(other *ColumnIndex) bool {
+func (p *EncryptionAlgorithm) Equals(other *EncryptionAlgorithm) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if len(p.NullPages) != len(other.NullPages) {
-		return false
-	}
-	for i, _tgt := range p.NullPages {
-		_src18 := other.NullPages[i]
-		if _tgt != _src18 {
-			return false
-		}
-	}
-	if len(p.MinValues) != len(other.MinValues) {
-		return false
-	}
-	for i, _tgt := range p.MinValues {
-		_src19 := other.MinValues[i]
-		if bytes.Compare(_tgt, _src19) != 0 {
-			return false
-		}
-	}
-	if len(p.MaxValues) != len(other.MaxValues) {
-		return false
-	}
-	for i, _tgt := range p.MaxValues {
-		_src20 := other.MaxValues[i]
-		if bytes.Compare(_tgt, _src20) != 0 {
-			return false
-		}
-	}
-	if p.BoundaryOrder != other.BoundaryOrder {
+	if !p.AES_GCM_V1.Equals(other.AES_GCM_V1) {
 		return false
 	}
-	if len(p.NullCounts) != len(other.NullCounts) {
+	if !p.AES_GCM_CTR_V1.Equals(other.AES_GCM_CTR_V1) {
 		return false
 	}
-	for i, _tgt := range p.NullCounts {
-		_src21 := other.NullCounts[i]
-		if _tgt != _src21 {
-			return false
-		}
-	}
 	return true
 }
 
-func (p *ColumnIndex) String() string {
+func (p *EncryptionAlgorithm) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnIndex(%+v)", *p)
+	return fmt.Sprintf("EncryptionAlgorithm(%+v)", *p)
 }
 
+
 // Description for file metadata
 //
 // Attributes:
@@ -9218,14 +11406,21 @@ func (p *ColumnIndex) String() string {
 //
 // The obsolete min and max fields are always sorted by signed comparison
 // regardless of column_orders.
+//  - EncryptionAlgorithm: Encryption algorithm. This field is set only in encrypted files
+// with plaintext footer. Files with encrypted footer store algorithm id
+// in FileCryptoMetaData structure.
+//  - FooterSigningKeyMetadata: Retrieval metadata of key used for signing the footer.
+// Used only in encrypted files with plaintext footer.
 type FileMetaData struct {
-	Version          int32            `thrift:"version,1,required" db:"version" json:"version"`
-	Schema           []*SchemaElement `thrift:"schema,2,required" db:"schema" json:"schema"`
-	NumRows          int64            `thrift:"num_rows,3,required" db:"num_rows" json:"num_rows"`
-	RowGroups        []*RowGroup      `thrift:"row_groups,4,required" db:"row_groups" json:"row_groups"`
-	KeyValueMetadata []*KeyValue      `thrift:"key_value_metadata,5" db:"key_value_metadata" json:"key_value_metadata,omitempty"`
-	CreatedBy        *string          `thrift:"created_by,6" db:"created_by" json:"created_by,omitempty"`
-	ColumnOrders     []*ColumnOrder   `thrift:"column_orders,7" db:"column_orders" json:"column_orders,omitempty"`
+	Version                  int32                `thrift:"version,1,required" db:"version" json:"version"`
+	Schema                   []*SchemaElement     `thrift:"schema,2,required" db:"schema" json:"schema"`
+	NumRows                  int64                `thrift:"num_rows,3,required" db:"num_rows" json:"num_rows"`
+	RowGroups                []*RowGroup          `thrift:"row_groups,4,required" db:"row_groups" json:"row_groups"`
+	KeyValueMetadata         []*KeyValue          `thrift:"key_value_metadata,5" db:"key_value_metadata" json:"key_value_metadata,omitempty"`
+	CreatedBy                *string              `thrift:"created_by,6" db:"created_by" json:"created_by,omitempty"`
+	ColumnOrders             []*ColumnOrder       `thrift:"column_orders,7" db:"column_orders" json:"column_orders,omitempty"`
+	EncryptionAlgorithm      *EncryptionAlgorithm `thrift:"encryption_algorithm,8" db:"encryption_algorithm" json:"encryption_algorithm,omitempty"`
+	FooterSigningKeyMetadata []byte               `thrift:"footer_signing_key_metadata,9" db:"footer_signing_key_metadata" json:"footer_signing_key_metadata,omitempty"`
 }
 
 func NewFileMetaData() *FileMetaData {
@@ -9268,6 +11463,21 @@ var FileMetaData_ColumnOrders_DEFAULT []*ColumnOrder
 func (p *FileMetaData) GetColumnOrders() []*ColumnOrder {
 	return p.ColumnOrders
 }
+
+var FileMetaData_EncryptionAlgorithm_DEFAULT *EncryptionAlgorithm
+
+func (p *FileMetaData) GetEncryptionAlgorithm() *EncryptionAlgorithm {
+	if !p.IsSetEncryptionAlgorithm() {
+		return FileMetaData_EncryptionAlgorithm_DEFAULT
+	}
+	return p.EncryptionAlgorithm
+}
+
+var FileMetaData_FooterSigningKeyMetadata_DEFAULT []byte
+
+func (p *FileMetaData) GetFooterSigningKeyMetadata() []byte {
+	return p.FooterSigningKeyMetadata
+}
 func (p *FileMetaData) IsSetKeyValueMetadata() bool {
 	return p.KeyValueMetadata != nil
 }
@@ -9280,6 +11490,14 @@ func (p *FileMetaData) IsSetColumnOrders() bool {
 	return p.ColumnOrders != nil
 }
 
+func (p *FileMetaData) IsSetEncryptionAlgorithm() bool {
+	return p.EncryptionAlgorithm != nil
+}
+
+func (p *FileMetaData) IsSetFooterSigningKeyMetadata() bool {
+	return p.FooterSigningKeyMetadata != nil
+}
+
 func (p *FileMetaData) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -9373,6 +11591,26 @@ func (p *FileMetaData) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -9507,6 +11745,23 @@ func (p *FileMetaData) ReadField7(ctx context.Context, iprot thrift.TProtocol) e
 	return nil
 }
 
+func (p *FileMetaData) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	p.EncryptionAlgorithm = &EncryptionAlgorithm{}
+	if err := p.EncryptionAlgorithm.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EncryptionAlgorithm), err)
+	}
+	return nil
+}
+
+func (p *FileMetaData) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.FooterSigningKeyMetadata = v
+	}
+	return nil
+}
+
 func (p *FileMetaData) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin(ctx, "FileMetaData"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -9533,6 +11788,12 @@ func (p *FileMetaData) Write(ctx context.Context, oprot thrift.TProtocol) error
 		if err := p.writeField7(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -9672,6 +11933,36 @@ func (p *FileMetaData) writeField7(ctx context.Context, oprot thrift.TProtocol)
 	return err
 }
 
+func (p *FileMetaData) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetEncryptionAlgorithm() {
+		if err := oprot.WriteFieldBegin(ctx, "encryption_algorithm", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:encryption_algorithm: ", p), err)
+		}
+		if err := p.EncryptionAlgorithm.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EncryptionAlgorithm), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:encryption_algorithm: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFooterSigningKeyMetadata() {
+		if err := oprot.WriteFieldBegin(ctx, "footer_signing_key_metadata", thrift.STRING, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:footer_signing_key_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.FooterSigningKeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.footer_signing_key_metadata (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:footer_signing_key_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *FileMetaData) Equals(other *FileMetaData) bool {
 	if p == other {
 		return true
@@ -9728,6 +12019,12 @@ func (p *FileMetaData) Equals(other *FileMetaData) bool {
 			return false
 		}
 	}
+	if !p.EncryptionAlgorithm.Equals(other.EncryptionAlgorithm) {
+		return false
+	}
+	if bytes.Compare(p.FooterSigningKeyMetadata, other.FooterSigningKeyMetadata) != 0 {
+		return false
+	}
 	return true
 }
 
@@ -9737,3 +12034,187 @@ func (p *FileMetaData) String() string {
 	}
 	return fmt.Sprintf("FileMetaData(%+v)", *p)
 }
+
+
+// Crypto metadata for files with encrypted footer *
+//
+// Attributes:
+//  - EncryptionAlgorithm: Encryption algorithm. This field is only used for files
+// with encrypted footer. Files with plaintext footer store algorithm id
+// inside footer (FileMetaData structure).
+//  - KeyMetadata: Retrieval metadata of key used for encryption of footer,
+//  and (possibly) columns *
+type FileCryptoMetaData struct {
+	EncryptionAlgorithm *EncryptionAlgorithm `thrift:"encryption_algorithm,1,required" db:"encryption_algorithm" json:"encryption_algorithm"`
+	KeyMetadata         []byte               `thrift:"key_metadata,2" db:"key_metadata" json:"key_metadata,omitempty"`
+}
+
+func NewFileCryptoMetaData() *FileCryptoMetaData {
+	return &FileCryptoMetaData{}
+}
+
+var FileCryptoMetaData_EncryptionAlgorithm_DEFAULT *EncryptionAlgorithm
+
+func (p *FileCryptoMetaData) GetEncryptionAlgorithm() *EncryptionAlgorithm {
+	if !p.IsSetEncryptionAlgorithm() {
+		return FileCryptoMetaData_EncryptionAlgorithm_DEFAULT
+	}
+	return p.EncryptionAlgorithm
+}
+
+var FileCryptoMetaData_KeyMetadata_DEFAULT []byte
+
+func (p *FileCryptoMetaData) GetKeyMetadata() []byte {
+	return p.KeyMetadata
+}
+func (p *FileCryptoMetaData) IsSetEncryptionAlgorithm() bool {
+	return p.EncryptionAlgorithm != nil
+}
+
+func (p *FileCryptoMetaData) IsSetKeyMetadata() bool {
+	return p.KeyMetadata != nil
+}
+
+func (p *FileCryptoMetaData) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetEncryptionAlgorithm bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+				issetEncryptionAlgorithm = true
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetEncryptionAlgorithm {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field EncryptionAlgorithm is not set"))
+	}
+	return nil
+}
+
+func (p *FileCryptoMetaData) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.EncryptionAlgorithm = &EncryptionAlgorithm{}
+	if err := p.EncryptionAlgorithm.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EncryptionAlgorithm), err)
+	}
+	return nil
+}
+
+func (p *FileCryptoMetaData) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.KeyMetadata = v
+	}
+	return nil
+}
+
+func (p *FileCryptoMetaData) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FileCryptoMetaData"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FileCryptoMetaData) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "encryption_algorithm", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:encryption_algorithm: ", p), err)
+	}
+	if err := p.EncryptionAlgorithm.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EncryptionAlgorithm), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:encryption_algorithm: ", p), err)
+	}
+	return err
+}
+
+func (p *FileCryptoMetaData) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyMetadata() {
+		if err := oprot.WriteFieldBegin(ctx, "key_metadata", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:key_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.KeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.key_metadata (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:key_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileCryptoMetaData) Equals(other *FileCryptoMetaData) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.EncryptionAlgorithm.Equals(other.EncryptionAlgorithm) {
+		return false
+	}
+	if bytes.Compare(p.KeyMetadata, other.KeyMetadata) != 0 {
+		return false
+	}
+	return true
+}
+
+func (p *FileCryptoMetaData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FileCryptoMetaData(%+v)", *p)
+}
+
@@ -3,6 +3,7 @@ module github.com/minio/parquet-go
 go 1.15
 
 require (
+	github.com/andybalholm/brotli v1.0.4
 	github.com/apache/thrift v0.15.0
 	github.com/frankban/quicktest v1.12.1 // indirect
 	github.com/klauspost/compress v1.12.2
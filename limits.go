@@ -0,0 +1,150 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"fmt"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// Limits - denotes resource limits of reading untrusted files. Zero value of a field means no limit.
+type Limits struct {
+	MaxFooterSize       int64 // Maximum size of file footer in bytes.
+	MaxPageSize         int64 // Maximum compressed size of a page in bytes.
+	MaxUncompressedSize int64 // Maximum uncompressed size of a page in bytes.
+	MaxValues           int64 // Maximum number of values of a data or dictionary page.
+	MaxSchemaDepth      int   // Maximum nesting depth of schema.
+	MaxRowGroups        int   // Maximum number of row groups.
+}
+
+// DefaultLimits - returns limits suitable for reading untrusted files.
+func DefaultLimits() *Limits {
+	return &Limits{
+		MaxFooterSize:       16 << 20,
+		MaxPageSize:         64 << 20,
+		MaxUncompressedSize: 256 << 20,
+		MaxValues:           16 << 20,
+		MaxSchemaDepth:      64,
+		MaxRowGroups:        1 << 16,
+	}
+}
+
+func (limits *Limits) checkFooterSize(size int64) error {
+	if limits != nil && limits.MaxFooterSize > 0 && size > limits.MaxFooterSize {
+		return fmt.Errorf("%w: footer size %v exceeds %v", ErrLimitExceeded, size, limits.MaxFooterSize)
+	}
+
+	return nil
+}
+
+func (limits *Limits) checkFileMetadata(fileMeta *parquet.FileMetaData) error {
+	if limits == nil {
+		return nil
+	}
+
+	if limits.MaxRowGroups > 0 && len(fileMeta.GetRowGroups()) > limits.MaxRowGroups {
+		return fmt.Errorf("%w: row group count %v exceeds %v", ErrLimitExceeded, len(fileMeta.GetRowGroups()), limits.MaxRowGroups)
+	}
+
+	if limits.MaxSchemaDepth > 0 {
+		if depth := schemaDepth(fileMeta.GetSchema()); depth > limits.MaxSchemaDepth {
+			return fmt.Errorf("%w: schema depth %v exceeds %v", ErrLimitExceeded, depth, limits.MaxSchemaDepth)
+		}
+	}
+
+	return nil
+}
+
+func (limits *Limits) checkPageHeader(pageHeader *parquet.PageHeader) error {
+	if limits == nil {
+		return nil
+	}
+
+	if limits.MaxPageSize > 0 && int64(pageHeader.GetCompressedPageSize()) > limits.MaxPageSize {
+		return fmt.Errorf("%w: page size %v exceeds %v", ErrLimitExceeded, pageHeader.GetCompressedPageSize(), limits.MaxPageSize)
+	}
+
+	if limits.MaxUncompressedSize > 0 && int64(pageHeader.GetUncompressedPageSize()) > limits.MaxUncompressedSize {
+		return fmt.Errorf("%w: uncompressed page size %v exceeds %v", ErrLimitExceeded,
+			pageHeader.GetUncompressedPageSize(), limits.MaxUncompressedSize)
+	}
+
+	if limits.MaxValues > 0 {
+		var numValues int32
+		switch {
+		case pageHeader.IsSetDataPageHeader():
+			numValues = pageHeader.DataPageHeader.GetNumValues()
+		case pageHeader.IsSetDataPageHeaderV2():
+			numValues = pageHeader.DataPageHeaderV2.GetNumValues()
+		case pageHeader.IsSetDictionaryPageHeader():
+			numValues = pageHeader.DictionaryPageHeader.GetNumValues()
+		}
+
+		if int64(numValues) > limits.MaxValues {
+			return fmt.Errorf("%w: page value count %v exceeds %v", ErrLimitExceeded, numValues, limits.MaxValues)
+		}
+	}
+
+	return nil
+}
+
+// maxPageSize returns maximum compressed page size; zero means no limit.
+func (limits *Limits) maxPageSize() int64 {
+	if limits == nil {
+		return 0
+	}
+
+	return limits.MaxPageSize
+}
+
+// maxUncompressedSize returns maximum uncompressed page size; zero means no limit.
+func (limits *Limits) maxUncompressedSize() int64 {
+	if limits == nil {
+		return 0
+	}
+
+	return limits.MaxUncompressedSize
+}
+
+// schemaDepth returns nesting depth of schema elements excluding root element.
+func schemaDepth(schemaElements []*parquet.SchemaElement) (depth int) {
+	// Number of children yet to be walked of each group in current path.
+	var remaining []int32
+	for i, element := range schemaElements {
+		for len(remaining) > 0 && remaining[len(remaining)-1] <= 0 {
+			remaining = remaining[:len(remaining)-1]
+		}
+
+		if i > 0 {
+			if len(remaining) == 0 {
+				break
+			}
+
+			remaining[len(remaining)-1]--
+			if len(remaining) > depth {
+				depth = len(remaining)
+			}
+		}
+
+		if numChildren := element.GetNumChildren(); numChildren > 0 {
+			remaining = append(remaining, numChildren)
+		}
+	}
+
+	return depth
+}
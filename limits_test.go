@@ -0,0 +1,177 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"testing"
+
+	"github.com/minio/parquet-go/common"
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+func TestReaderLimits(t *testing.T) {
+	data := writePageErrorFile(t)
+
+	testCases := []struct {
+		limits      *Limits
+		expectErr   bool // Error from NewLimitedReader.
+		expectedErr error
+	}{
+		{nil, false, nil},
+		{DefaultLimits(), false, nil},
+		{&Limits{MaxFooterSize: 16}, true, ErrLimitExceeded},
+		{&Limits{MaxRowGroups: 1}, true, ErrLimitExceeded},
+		{&Limits{MaxRowGroups: 2, MaxSchemaDepth: 1}, false, nil},
+		{&Limits{MaxPageSize: 8}, false, ErrLimitExceeded},
+		{&Limits{MaxUncompressedSize: 4}, false, ErrLimitExceeded},
+		{&Limits{MaxValues: 1}, false, ErrLimitExceeded},
+	}
+
+	for i, testCase := range testCases {
+		reader, err := NewLimitedReader(bytesGetReaderFunc(data), nil, nil, testCase.limits)
+		if testCase.expectErr {
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("case %v: err: expected: %v, got: %v", i+1, testCase.expectedErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		var count int
+		for {
+			if _, err = reader.Read(); err != nil {
+				break
+			}
+			count++
+		}
+		reader.Close()
+
+		if testCase.expectedErr == nil {
+			if err != io.EOF || count != 4 {
+				t.Fatalf("case %v: expected: 4 rows, got: %v rows, err: %v", i+1, count, err)
+			}
+			continue
+		}
+
+		var pageErr *PageError
+		if !errors.Is(err, testCase.expectedErr) || !errors.As(err, &pageErr) {
+			t.Fatalf("case %v: err: expected: %v, got: %v", i+1, testCase.expectedErr, err)
+		}
+	}
+}
+
+func TestSchemaDepth(t *testing.T) {
+	element := func(name string, numChildren int32) *parquet.SchemaElement {
+		schemaElement := parquet.NewSchemaElement()
+		schemaElement.Name = name
+		if numChildren > 0 {
+			schemaElement.NumChildren = &numChildren
+		}
+		return schemaElement
+	}
+
+	testCases := []struct {
+		schemaElements []*parquet.SchemaElement
+		expectedResult int
+	}{
+		{nil, 0},
+		{[]*parquet.SchemaElement{element("schema", 0)}, 0},
+		{[]*parquet.SchemaElement{element("schema", 2), element("a", 0), element("b", 0)}, 1},
+		{[]*parquet.SchemaElement{
+			element("schema", 2), element("a", 1), element("list", 1), element("element", 0), element("b", 0),
+		}, 3},
+		// Elements beyond children of root are ignored.
+		{[]*parquet.SchemaElement{element("schema", 1), element("a", 0), element("b", 1), element("c", 0)}, 1},
+		// Missing children are ignored.
+		{[]*parquet.SchemaElement{element("schema", 1), element("a", 5), element("b", 0)}, 2},
+	}
+
+	for i, testCase := range testCases {
+		if result := schemaDepth(testCase.schemaElements); result != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestReaderManyPages(t *testing.T) {
+	// Column chunk of dictionary encoded column 'a' is prefixed by many empty dictionary pages.
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, int32SchemaTree(t), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		if err = writer.WriteJSON([]byte(fmt.Sprintf(`{"a": %v}`, i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewBytesReader(buf.Bytes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileMeta := reader.FileMetaData()
+	reader.Close()
+
+	metadata := fileMeta.RowGroups[0].Columns[0].MetaData
+	pageData, err := common.Compress(metadata.Codec, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pageHeader := &parquet.PageHeader{
+		Type:                 parquet.PageType_DICTIONARY_PAGE,
+		CompressedPageSize:   int32(len(pageData)),
+		DictionaryPageHeader: &parquet.DictionaryPageHeader{Encoding: parquet.Encoding_PLAIN},
+	}
+	page, err := serializeThrift(pageHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page = append(page, pageData...)
+
+	var pages []byte
+	for i := 0; i < 50000; i++ {
+		pages = append(pages, page...)
+	}
+
+	offset := metadata.GetDictionaryPageOffset()
+	data := append(append(append([]byte{}, buf.Bytes()[:offset]...), pages...), buf.Bytes()[offset:]...)
+	metadata.DataPageOffset += int64(len(pages))
+	metadata.TotalCompressedSize += int64(len(pages))
+
+	// Pages are read in a loop, hence stack does not grow by number of pages.
+	defer debug.SetMaxStack(debug.SetMaxStack(8 << 20))
+
+	reader, err = NewReaderWithMetadata(bytesGetReaderFunc(data), fileMeta, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if result := readColumnA(t, reader); result != "[1 2 3]" {
+		t.Fatalf("expected: [1 2 3], got: %v", result)
+	}
+}
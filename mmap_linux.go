@@ -0,0 +1,52 @@
+//go:build linux
+// +build linux
+
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+var errMmapUnsupported = errors.New("mmap not supported")
+
+// mmapFile maps whole file read only and returns mapped data with function to unmap it.
+func mmapFile(file *os.File) ([]byte, func() error, error) {
+	fi, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Empty file is not mappable.
+	if fi.Size() == 0 {
+		return []byte{}, func() error { return nil }, nil
+	}
+
+	if int64(int(fi.Size())) != fi.Size() {
+		return nil, nil, errMmapUnsupported
+	}
+
+	data, err := syscall.Mmap(int(file.Fd()), 0, int(fi.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, func() error { return syscall.Munmap(data) }, nil
+}
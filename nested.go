@@ -0,0 +1,205 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"fmt"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+// schemaPaths returns dotted paths of schemaElements in depth first order; root element is skipped,
+// hence path at index i is of schemaElements[i+1].
+func schemaPaths(schemaElements []*parquet.SchemaElement) (paths []string) {
+	var walk func(index int, prefix string) int
+	walk = func(index int, prefix string) int {
+		numChildren := int(schemaElements[index].GetNumChildren())
+		index++
+		for i := 0; i < numChildren && index < len(schemaElements); i++ {
+			path := schema.JoinPath([]string{schemaElements[index].Name})
+			if prefix != "" {
+				path = prefix + "." + path
+			}
+
+			paths = append(paths, path)
+			index = walk(index, path)
+		}
+
+		return index
+	}
+
+	if len(schemaElements) > 0 && schemaElements[0] != nil {
+		walk(0, "")
+	}
+
+	return paths
+}
+
+// getRepeatedDefLevels returns definition levels of repeated elements in path.
+func getRepeatedDefLevels(nameIndexMap map[string]int, schemaElements []*parquet.SchemaElement, path []string) (levels []int32) {
+	var definitionLevel int32
+	for i := 1; i <= len(path); i++ {
+		index, ok := nameIndexMap[schema.JoinPath(path[:i])]
+		if !ok {
+			continue
+		}
+
+		switch schemaElements[index].GetRepetitionType() {
+		case parquet.FieldRepetitionType_OPTIONAL:
+			definitionLevel++
+		case parquet.FieldRepetitionType_REPEATED:
+			definitionLevel++
+			levels = append(levels, definitionLevel)
+		}
+	}
+
+	return levels
+}
+
+// assembleList returns nested list of values of a row of repeated column. Values of list of repeated
+// element at depth 0 start at repetition level <= 1, of nested list at depth 1 start at repetition
+// level <= 2 and so on. List is nil if an ancestor of the repeated element is null.
+func assembleList(values []interface{}, repetitionLevels, definitionLevels []int32, repeatedDefLevels []int32, depth int) interface{} {
+	definitionLevel := repeatedDefLevels[depth]
+	if definitionLevels[0] < definitionLevel {
+		if definitionLevels[0] < definitionLevel-1 {
+			return nil
+		}
+
+		return []interface{}{}
+	}
+
+	list := []interface{}{}
+	for start := 0; start < len(values); {
+		end := start + 1
+		for end < len(values) && repetitionLevels[end] > int32(depth+1) {
+			end++
+		}
+
+		if depth+1 == len(repeatedDefLevels) {
+			list = append(list, values[start])
+		} else {
+			list = append(list, assembleList(values[start:end], repetitionLevels[start:end],
+				definitionLevels[start:end], repeatedDefLevels, depth+1))
+		}
+
+		start = end
+	}
+
+	return list
+}
+
+// nestedColumn - denotes group in which values of repeated column are surfaced in record.
+type nestedColumn struct {
+	name     string // Path of LIST or MAP group.
+	schema   *parquet.SchemaElement
+	mapKey   bool // Column is key of MAP group.
+	mapValue bool // Column is value of MAP group.
+}
+
+// leafCount returns number of value elements in element.
+func leafCount(element *schema.Element) (count int) {
+	if element.Type != nil {
+		return 1
+	}
+
+	if element.Children != nil {
+		element.Children.Range(func(name string, element *schema.Element) bool {
+			count += leafCount(element)
+			return true
+		})
+	}
+
+	return count
+}
+
+// findNestedColumns adds columns of LIST and MAP groups of tree to nested. Legacy structures are resolved
+// as per backward compatibility rules. LIST of single column is surfaced as list, MAP of primitive key
+// and value as map; columns of other structures are surfaced by their paths.
+func findNestedColumns(tree *schema.Tree, nested map[string]nestedColumn) {
+	tree.Range(func(name string, element *schema.Element) bool {
+		if element.Type != nil || element.Children == nil {
+			return true
+		}
+
+		if element.ConvertedType != nil && *element.RepetitionType != parquet.FieldRepetitionType_REPEATED {
+			switch *element.ConvertedType {
+			case parquet.ConvertedType_LIST:
+				if listElement, err := element.ListElement(); err == nil && leafCount(listElement) == 1 {
+					leaf := listElement
+					if leaf.Type == nil {
+						leaf = leafElements(listElement.Children, nil)[0]
+					}
+					nested[leaf.PathInSchema] = nestedColumn{name: element.PathInSchema, schema: &element.SchemaElement}
+					return true
+				}
+
+			case parquet.ConvertedType_MAP, parquet.ConvertedType_MAP_KEY_VALUE:
+				key, value, err := element.MapKeyValue()
+				if err == nil && key.Type != nil && key.MaxRepetitionLevel == 1 && (value == nil || value.Type != nil) {
+					nested[key.PathInSchema] = nestedColumn{name: element.PathInSchema, schema: &element.SchemaElement, mapKey: true}
+					if value != nil {
+						nested[value.PathInSchema] = nestedColumn{name: element.PathInSchema, schema: &element.SchemaElement, mapValue: true}
+					}
+					return true
+				}
+			}
+		}
+
+		findNestedColumns(element.Children, nested)
+		return true
+	})
+}
+
+// newNestedColumns returns nested columns of LIST and MAP groups by column path. It returns nil if
+// schemaElements are not convertible to schema tree.
+func newNestedColumns(schemaElements []*parquet.SchemaElement) map[string]nestedColumn {
+	tree, err := schema.FromParquetSchema(schemaElements)
+	if err != nil {
+		return nil
+	}
+
+	nested := make(map[string]nestedColumn)
+	findNestedColumns(tree, nested)
+	return nested
+}
+
+// mapOf returns map of keys and values of MAP group. Keys are converted to string.
+func mapOf(keys, values interface{}) interface{} {
+	keyList, ok := keys.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	valueList, _ := values.([]interface{})
+	result := make(map[string]interface{}, len(keyList))
+	for i, key := range keyList {
+		var value interface{}
+		if i < len(valueList) {
+			value = valueList[i]
+		}
+
+		if b, ok := key.([]byte); ok {
+			result[string(b)] = value
+		} else {
+			result[fmt.Sprint(key)] = value
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,176 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/minio/parquet-go/data"
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+func TestAssembleList(t *testing.T) {
+	testCases := []struct {
+		values            []interface{}
+		repetitionLevels  []int32
+		definitionLevels  []int32
+		repeatedDefLevels []int32
+		expectedResult    interface{}
+	}{
+		{[]interface{}{nil}, []int32{0}, []int32{0}, []int32{2}, nil},
+		{[]interface{}{nil}, []int32{0}, []int32{1}, []int32{2}, []interface{}{}},
+		{[]interface{}{1, 2}, []int32{0, 1}, []int32{2, 2}, []int32{2}, []interface{}{1, 2}},
+		{[]interface{}{1, nil}, []int32{0, 1}, []int32{3, 2}, []int32{2}, []interface{}{1, nil}},
+		// [[1, 2], [], null, [3]]
+		{
+			[]interface{}{1, 2, nil, nil, 3},
+			[]int32{0, 2, 1, 1, 1},
+			[]int32{4, 4, 3, 2, 4},
+			[]int32{2, 4},
+			[]interface{}{[]interface{}{1, 2}, []interface{}{}, nil, []interface{}{3}},
+		},
+	}
+
+	for i, testCase := range testCases {
+		result := assembleList(testCase.values, testCase.repetitionLevels, testCase.definitionLevels, testCase.repeatedDefLevels, 0)
+		if !reflect.DeepEqual(result, testCase.expectedResult) {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestReaderLegacyListMap(t *testing.T) {
+	// message schema {
+	//   optional group a (LIST) { repeated int32 array; }
+	//   optional group b (LIST) { repeated group bag { optional int32 array_element; } }
+	//   optional group m (MAP) { repeated group map (MAP_KEY_VALUE) { required binary key (UTF8); optional int32 value; } }
+	// }
+	optional := parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL)
+	repeated := parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REPEATED)
+	required := parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	numChildren := func(n int32) *int32 { return &n }
+	schemaList := []*parquet.SchemaElement{
+		{Name: "schema", NumChildren: numChildren(3)},
+		{Name: "a", RepetitionType: optional, NumChildren: numChildren(1), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_LIST)},
+		{Name: "array", RepetitionType: repeated, Type: parquet.TypePtr(parquet.Type_INT32)},
+		{Name: "b", RepetitionType: optional, NumChildren: numChildren(1), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_LIST)},
+		{Name: "bag", RepetitionType: repeated, NumChildren: numChildren(1)},
+		{Name: "array_element", RepetitionType: optional, Type: parquet.TypePtr(parquet.Type_INT32)},
+		{Name: "m", RepetitionType: optional, NumChildren: numChildren(1), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_MAP)},
+		{Name: "map", RepetitionType: repeated, NumChildren: numChildren(2), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_MAP_KEY_VALUE)},
+		{Name: "key", RepetitionType: required, Type: parquet.TypePtr(parquet.Type_BYTE_ARRAY), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)},
+		{Name: "value", RepetitionType: optional, Type: parquet.TypePtr(parquet.Type_INT32)},
+	}
+
+	schemaTree, err := schema.FromParquetSchema(schemaList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"a.array", "b.bag.array_element", "m.map.key", "m.map.value"} {
+		element, _ := schemaTree.Get(name)
+		element.Encoding = parquet.EncodingPtr(parquet.Encoding_PLAIN)
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type level struct {
+		value  interface{}
+		DL, RL int64
+	}
+
+	add := func(parquetType parquet.Type, levels ...level) *data.Column {
+		column := data.NewColumn(parquetType)
+		for _, l := range levels {
+			switch value := l.value.(type) {
+			case nil:
+				column.AddNull(l.DL, l.RL)
+			case int32:
+				column.AddInt32(value, l.DL, l.RL)
+			case string:
+				column.AddByteArray([]byte(value), l.DL, l.RL)
+			}
+		}
+		return column
+	}
+
+	records := []map[string]*data.Column{
+		{
+			"a.array":             add(parquet.Type_INT32, level{int32(1), 2, 0}, level{int32(2), 2, 1}),
+			"b.bag.array_element": add(parquet.Type_INT32, level{int32(3), 3, 0}, level{nil, 2, 1}),
+			"m.map.key":           add(parquet.Type_BYTE_ARRAY, level{"x", 2, 0}, level{"y", 2, 1}),
+			"m.map.value":         add(parquet.Type_INT32, level{int32(5), 3, 0}, level{int32(6), 3, 1}),
+		},
+		{
+			"a.array":             add(parquet.Type_INT32, level{nil, 1, 0}),
+			"b.bag.array_element": add(parquet.Type_INT32, level{nil, 0, 0}),
+			"m.map.key":           add(parquet.Type_BYTE_ARRAY, level{nil, 0, 0}),
+			"m.map.value":         add(parquet.Type_INT32, level{nil, 0, 0}),
+		},
+	}
+
+	for _, record := range records {
+		if err = writer.Write(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	expectedResult := []string{
+		"a:[1 2] b:[3 <nil>] m:map[x:5 y:6]",
+		"a:[] b:<nil> m:<nil>",
+	}
+
+	var result []string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		values := ""
+		record.Range(func(name string, value Value) bool {
+			values += fmt.Sprintf(" %v:%v", name, value.Value)
+			return true
+		})
+		result = append(result, values[1:])
+	}
+
+	if !reflect.DeepEqual(result, expectedResult) {
+		t.Fatalf("expected: %v, got: %v", expectedResult, result)
+	}
+}
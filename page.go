@@ -23,10 +23,11 @@ import (
 	"fmt"
 	"io"
 	"math"
-	"strings"
 
 	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/minio/parquet-go/common"
 	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
 )
 
 // getBitWidth - returns bits required to place num e.g.
@@ -53,7 +54,7 @@ func getBitWidth(num uint64) (width uint64) {
 // getMaxDefLevel - get maximum definition level.
 func getMaxDefLevel(nameIndexMap map[string]int, schemaElements []*parquet.SchemaElement, path []string) (v int) {
 	for i := 1; i <= len(path); i++ {
-		name := strings.Join(path[:i], ".")
+		name := schema.JoinPath(path[:i])
 		if index, ok := nameIndexMap[name]; ok {
 			if schemaElements[index].GetRepetitionType() != parquet.FieldRepetitionType_REQUIRED {
 				v++
@@ -67,7 +68,7 @@ func getMaxDefLevel(nameIndexMap map[string]int, schemaElements []*parquet.Schem
 // getMaxRepLevel - get maximum repetition level.
 func getMaxRepLevel(nameIndexMap map[string]int, schemaElements []*parquet.SchemaElement, path []string) (v int) {
 	for i := 1; i <= len(path); i++ {
-		name := strings.Join(path[:i], ".")
+		name := schema.JoinPath(path[:i])
 		if index, ok := nameIndexMap[name]; ok {
 			if schemaElements[index].GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
 				v++
@@ -78,27 +79,108 @@ func getMaxRepLevel(nameIndexMap map[string]int, schemaElements []*parquet.Schem
 	return v
 }
 
-func readPageHeader(reader *thrift.TBufferedTransport) (*parquet.PageHeader, error) {
+func readPageHeader(ctx context.Context, reader thrift.TTransport) (*parquet.PageHeader, error) {
 	pageHeader := parquet.NewPageHeader()
-	if err := pageHeader.Read(context.Background(), thrift.NewTCompactProtocol(reader)); err != nil {
+	if err := pageHeader.Read(ctx, thrift.NewTCompactProtocol(reader)); err != nil {
 		return nil, err
 	}
 
 	return pageHeader, nil
 }
 
+// isLengthPrefixed returns whether data starts with its length as of data page v1 levels.
+func isLengthPrefixed(data []byte) bool {
+	return len(data) >= 4 && uint64(bytesToUint32(data[:4])) == uint64(len(data)-4)
+}
+
+// fromLegacyLayout converts data of data page of layout written by releases before created_by was written, to
+// layout of data page v1. Those releases always wrote both levels length prefixed, also if their max level is zero,
+// and prefixed dictionary indices by their length. It returns false if data is not of that layout.
+func fromLegacyLayout(data []byte, maxRepetitionLevel, maxDefinitionLevel int, dictionary bool) ([]byte, bool) {
+	var levels [2][]byte
+	for i := range levels {
+		if len(data) < 4 || uint64(bytesToUint32(data[:4])) > uint64(len(data)-4) {
+			return nil, false
+		}
+
+		size := 4 + int(bytesToUint32(data[:4]))
+		levels[i], data = data[:size], data[size:]
+	}
+
+	if dictionary {
+		// Bit width of indices is followed by length of indices.
+		if len(data) < 5 || !isLengthPrefixed(data[1:]) {
+			return nil, false
+		}
+		data = append([]byte{data[0]}, data[5:]...)
+	}
+
+	var result []byte
+	if maxRepetitionLevel > 0 {
+		result = append(result, levels[0]...)
+	}
+	if maxDefinitionLevel > 0 {
+		result = append(result, levels[1]...)
+	}
+
+	return append(result, data...), true
+}
+
 func readPage(
-	thriftReader *thrift.TBufferedTransport,
+	ctx context.Context,
+	thriftReader thrift.TTransport,
 	metadata *parquet.ColumnMetaData,
 	columnNameIndexMap map[string]int,
 	schemaElements []*parquet.SchemaElement,
+	verifyChecksum bool,
+	decryptor *pageDecryptor,
+	limits *Limits,
+	legacyLayout bool,
 ) (page *page, definitionLevels, numRows int64, err error) {
 
-	pageHeader, err := readPageHeader(thriftReader)
+	var pageHeader *parquet.PageHeader
+	if decryptor != nil {
+		pageHeader, err = decryptor.readPageHeader(thriftReader)
+	} else {
+		pageHeader, err = readPageHeader(ctx, thriftReader)
+	}
 	if err != nil {
+		if isEOF(err) {
+			err = fmt.Errorf("%w: page header: %v", ErrTruncated, err)
+		}
+		return nil, 0, 0, err
+	}
+
+	// Errors after page header is read are of data of the page.
+	defer func() {
+		if err != nil {
+			err = &pageDataError{header: pageHeader, err: err}
+		}
+	}()
+
+	if pageHeader.GetCompressedPageSize() < 0 || pageHeader.GetUncompressedPageSize() < 0 {
+		return nil, 0, 0, errors.New("parquet: negative page size")
+	}
+
+	if err = limits.checkPageHeader(pageHeader); err != nil {
 		return nil, 0, 0, err
 	}
 
+	// Decrypted page is read from memory.
+	var pageReader io.Reader = thriftReader
+	compressedPageSize := pageHeader.GetCompressedPageSize()
+	if decryptor != nil {
+		pageData, err := decryptor.readPage(thriftReader, limits.maxPageSize())
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		pageReader = bytes.NewReader(pageData)
+		compressedPageSize = int32(len(pageData))
+	}
+
+	// Levels of data page v2 of legacy layout are already length prefixed.
+	var legacyLevels bool
 	read := func() (data []byte, err error) {
 		var repLevelsLen, defLevelsLen int32
 		var repLevelsBuf, defLevelsBuf []byte
@@ -108,42 +190,49 @@ func readPage(
 				return nil, errors.New("parquet: Header not set")
 			}
 			repLevelsLen = pageHeader.DataPageHeaderV2.GetRepetitionLevelsByteLength()
-			repLevelsBuf = make([]byte, repLevelsLen)
-
-			n, err := io.ReadFull(thriftReader, repLevelsBuf)
-			if err != nil {
-				return nil, err
-			}
-			if n != int(repLevelsLen) {
-				return nil, fmt.Errorf("expected parquet header repetition levels %d, got %d", repLevelsLen, n)
-			}
-
 			defLevelsLen = pageHeader.DataPageHeaderV2.GetDefinitionLevelsByteLength()
-			defLevelsBuf = make([]byte, defLevelsLen)
+			if repLevelsLen < 0 || defLevelsLen < 0 {
+				return nil, errors.New("parquet: negative levels length")
+			}
 
-			n, err = io.ReadFull(thriftReader, defLevelsBuf)
-			if err != nil {
-				return nil, err
+			if repLevelsBuf, err = readBytes(pageReader, int64(repLevelsLen)); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrTruncated, err)
 			}
-			if n != int(defLevelsLen) {
-				return nil, fmt.Errorf("expected parquet header definition levels %d, got %d", defLevelsLen, n)
+
+			if defLevelsBuf, err = readBytes(pageReader, int64(defLevelsLen)); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrTruncated, err)
 			}
 		}
-		dbLen := pageHeader.GetCompressedPageSize() - repLevelsLen - defLevelsLen
+		dbLen := int64(compressedPageSize) - int64(repLevelsLen) - int64(defLevelsLen)
 		if dbLen < 0 {
 			return nil, errors.New("parquet: negative data length")
 		}
 
-		dataBuf := make([]byte, dbLen)
-		n, err := io.ReadFull(thriftReader, dataBuf)
+		dataBuf, err := readBytes(pageReader, dbLen)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%w: %v", ErrTruncated, err)
 		}
-		if n != int(dbLen) {
-			return nil, fmt.Errorf("expected parquet data buffer %d, got %d", dbLen, n)
+
+		if verifyChecksum && pageHeader.IsSetCrc() {
+			checksum := common.PageChecksum(repLevelsBuf, defLevelsBuf, dataBuf)
+			if *checksum != pageHeader.GetCrc() {
+				return nil, &ChecksumError{
+					Expected: uint32(pageHeader.GetCrc()),
+					Actual:   uint32(*checksum),
+				}
+			}
+		}
+
+		// Levels of data page v2 are not compressed, hence uncompressed size limit of data excludes them.
+		uncompressedLimit := limits.maxUncompressedSize()
+		if uncompressedLimit > 0 {
+			if uncompressedLimit -= int64(repLevelsLen) + int64(defLevelsLen); uncompressedLimit <= 0 {
+				return nil, fmt.Errorf("%w: levels length exceeds uncompressed page size %v", ErrLimitExceeded,
+					limits.maxUncompressedSize())
+			}
 		}
 
-		if dataBuf, err = compressionCodec(metadata.GetCodec()).uncompress(dataBuf); err != nil {
+		if dataBuf, err = compressionCodec(metadata.GetCodec()).uncompressLimit(dataBuf, uncompressedLimit); err != nil {
 			return nil, err
 		}
 
@@ -151,6 +240,13 @@ func readPage(
 			return dataBuf, nil
 		}
 
+		if legacyLayout && isLengthPrefixed(repLevelsBuf) && isLengthPrefixed(defLevelsBuf) {
+			legacyLevels = true
+			data = append(data, repLevelsBuf...)
+			data = append(data, defLevelsBuf...)
+			return append(data, dataBuf...), nil
+		}
+
 		if repLevelsLen > 0 {
 			data = append(data, uint32ToBytes(uint32(repLevelsLen))...)
 			data = append(data, repLevelsBuf...)
@@ -179,7 +275,7 @@ func readPage(
 	pageType := pageHeader.GetType()
 	switch pageType {
 	case parquet.PageType_INDEX_PAGE:
-		return nil, 0, 0, fmt.Errorf("page type %v is not supported", parquet.PageType_INDEX_PAGE)
+		return nil, 0, 0, fmt.Errorf("%w: page type %v", ErrUnsupportedEncoding, parquet.PageType_INDEX_PAGE)
 
 	case parquet.PageType_DICTIONARY_PAGE:
 		page = newDictPage()
@@ -189,6 +285,9 @@ func readPage(
 		if pageHeader.DictionaryPageHeader == nil {
 			return nil, 0, 0, errors.New("parquet: dictionary not set")
 		}
+		if pageHeader.DictionaryPageHeader.GetNumValues() < 0 {
+			return nil, 0, 0, errors.New("parquet: negative numvalues")
+		}
 		values, err := readValues(bytesReader, metadata.GetType(),
 			uint64(pageHeader.DictionaryPageHeader.GetNumValues()), 0)
 		if err != nil {
@@ -200,7 +299,7 @@ func readPage(
 		return page, 0, 0, nil
 
 	case parquet.PageType_DATA_PAGE, parquet.PageType_DATA_PAGE_V2:
-		name := strings.Join(path, ".")
+		name := schema.JoinPath(path)
 
 		page = newDataPage()
 		page.Header = pageHeader
@@ -215,16 +314,31 @@ func readPage(
 			if pageHeader.DataPageHeader == nil {
 				return nil, 0, 0, errors.New("parquet: Header not set")
 			}
+			if pageHeader.DataPageHeader.GetNumValues() < 0 {
+				return nil, 0, 0, errors.New("parquet: negative numvalues")
+			}
 			numValues = uint64(pageHeader.DataPageHeader.GetNumValues())
 			encodingType = pageHeader.DataPageHeader.GetEncoding()
 		} else {
 			if pageHeader.DataPageHeaderV2 == nil {
 				return nil, 0, 0, errors.New("parquet: Header not set")
 			}
+			if pageHeader.DataPageHeaderV2.GetNumValues() < 0 {
+				return nil, 0, 0, errors.New("parquet: negative numvalues")
+			}
 			numValues = uint64(pageHeader.DataPageHeaderV2.GetNumValues())
 			encodingType = pageHeader.DataPageHeaderV2.GetEncoding()
 		}
 
+		// Data pages of legacy layout are v2 pages of length prefixed levels and v1 pages of dictionary indices.
+		dictionary := pageType == parquet.PageType_DATA_PAGE &&
+			(encodingType == parquet.Encoding_RLE_DICTIONARY || encodingType == parquet.Encoding_PLAIN_DICTIONARY)
+		if legacyLevels || (legacyLayout && dictionary) {
+			if data, ok := fromLegacyLayout(buf, maxRepetitionLevel, maxDefinitionLevel, dictionary); ok {
+				bytesReader = bytes.NewReader(data)
+			}
+		}
+
 		var repetitionLevels []int64
 		if maxRepetitionLevel > 0 {
 			values, _, err := readDataPageValues(bytesReader, parquet.Encoding_RLE, parquet.Type_INT64,
@@ -312,6 +426,30 @@ func readPage(
 	return nil, 0, 0, fmt.Errorf("unknown page type %v", pageType)
 }
 
+// isEOF returns whether err is due to end of data.
+func isEOF(err error) bool {
+	var transportErr thrift.TTransportException
+	if errors.As(err, &transportErr) && transportErr.TypeId() == thrift.END_OF_FILE {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// pageDataError - denotes error in data of page whose header is read.
+type pageDataError struct {
+	header *parquet.PageHeader
+	err    error
+}
+
+func (err *pageDataError) Error() string {
+	return err.err.Error()
+}
+
+func (err *pageDataError) Unwrap() error {
+	return err.err
+}
+
 type page struct {
 	Header       *parquet.PageHeader      // Header of a page
 	DataTable    *table                   // Table to store values
@@ -322,6 +460,7 @@ type page struct {
 	MaxVal       interface{}              // Maximum of the values
 	MinVal       interface{}              // Minimum of the values
 	PageSize     int32
+	PageChecksum bool // Sets CRC32 checksum of compressed page data in header
 }
 
 func newPage() *page {
@@ -343,22 +482,24 @@ func newDataPage() *page {
 	return page
 }
 
-func (page *page) decode(dictPage *page) {
+func (page *page) decode(dictPage *page) error {
 	if dictPage == nil || page == nil || page.Header.DataPageHeader == nil ||
 		(page.Header.DataPageHeader.Encoding != parquet.Encoding_RLE_DICTIONARY &&
 			page.Header.DataPageHeader.Encoding != parquet.Encoding_PLAIN_DICTIONARY) {
-		return
+		return nil
 	}
 
 	for i := 0; i < len(page.DataTable.Values); i++ {
 		if page.DataTable.Values[i] != nil {
 			index, ok := page.DataTable.Values[i].(int64)
-			if !ok || int(index) >= len(dictPage.DataTable.Values) {
-				return
+			if !ok || index < 0 || int(index) >= len(dictPage.DataTable.Values) {
+				return fmt.Errorf("dictionary index %v out of range", page.DataTable.Values[i])
 			}
 			page.DataTable.Values[i] = dictPage.DataTable.Values[index]
 		}
 	}
+
+	return nil
 }
 
 // Get RepetitionLevels and Definitions from RawData
@@ -469,7 +610,7 @@ func (page *page) getRLDLFromRawData(columnNameIndexMap map[string]int, schemaEl
 
 		table := new(table)
 		table.Path = page.Path
-		name := strings.Join(page.Path, ".")
+		name := schema.JoinPath(page.Path)
 		table.RepetitionType = schemaElements[columnNameIndexMap[name]].GetRepetitionType()
 		table.MaxRepetitionLevel = int32(maxRepetitionLevel)
 		table.MaxDefinitionLevel = int32(maxDefinitionLevel)
@@ -528,7 +669,7 @@ func (page *page) getValueFromRawData(columnNameIndexMap map[string]int, schemaE
 			}
 		}
 
-		name := strings.Join(page.DataTable.Path, ".")
+		name := schema.JoinPath(page.DataTable.Path)
 		var convertedType parquet.ConvertedType = -1
 
 		if schemaElements[columnNameIndexMap[name]].IsSetConvertedType() {
@@ -612,6 +753,9 @@ func (page *page) toDataPage(compressType parquet.CompressionCodec) []byte {
 	page.Header.DataPageHeader.DefinitionLevelEncoding = parquet.Encoding_RLE
 	page.Header.DataPageHeader.RepetitionLevelEncoding = parquet.Encoding_RLE
 	page.Header.DataPageHeader.Encoding = page.DataTable.Encoding
+	if page.PageChecksum {
+		page.Header.Crc = common.PageChecksum(compressedData)
+	}
 	page.Header.DataPageHeader.Statistics = parquet.NewStatistics()
 	if page.MaxVal != nil {
 		tmpBuf := valueToBytes(page.MaxVal, page.DataType)
@@ -701,6 +845,9 @@ func (page *page) toDataPageV2(compressType parquet.CompressionCodec) []byte {
 	page.Header.DataPageHeaderV2.DefinitionLevelsByteLength = int32(len(defLevelBytes))
 	page.Header.DataPageHeaderV2.RepetitionLevelsByteLength = int32(len(repLevelBytes))
 	page.Header.DataPageHeaderV2.IsCompressed = true
+	if page.PageChecksum {
+		page.Header.Crc = common.PageChecksum(repLevelBytes, defLevelBytes, compressedData)
+	}
 
 	page.Header.DataPageHeaderV2.Statistics = parquet.NewStatistics()
 	if page.MaxVal != nil {
@@ -752,6 +899,9 @@ func (page *page) toDictPage(compressType parquet.CompressionCodec, dataType par
 	page.Header.DictionaryPageHeader = parquet.NewDictionaryPageHeader()
 	page.Header.DictionaryPageHeader.NumValues = int32(len(page.DataTable.Values))
 	page.Header.DictionaryPageHeader.Encoding = parquet.Encoding_PLAIN
+	if page.PageChecksum {
+		page.Header.Crc = common.PageChecksum(compressedData)
+	}
 
 	ts := thrift.NewTSerializer()
 	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
@@ -810,6 +960,9 @@ func (page *page) toDictDataPage(compressType parquet.CompressionCodec, bitWidth
 	page.Header.DataPageHeader.DefinitionLevelEncoding = parquet.Encoding_RLE
 	page.Header.DataPageHeader.RepetitionLevelEncoding = parquet.Encoding_RLE
 	page.Header.DataPageHeader.Encoding = parquet.Encoding_PLAIN_DICTIONARY
+	if page.PageChecksum {
+		page.Header.Crc = common.PageChecksum(compressedData)
+	}
 
 	ts := thrift.NewTSerializer()
 	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
@@ -0,0 +1,305 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+// projectedColumn maps value element of target schema to column of the file. Values of LIST and MAP
+// groups are read from and set to records by path of the group as Read surfaces them.
+type projectedColumn struct {
+	element      *schema.Element
+	fileColumn   string // Empty if the file has no such column.
+	defaultValue interface{}
+	name         string // Path in record of target schema.
+	fileName     string // Path in record of the file.
+	schema       *parquet.SchemaElement
+	mapKey       bool
+	mapValue     bool
+}
+
+// projection maps records of the file to target schema.
+type projection struct {
+	nameList []string
+	columns  []projectedColumn
+}
+
+// leafElements appends value elements of tree to elements.
+func leafElements(tree *schema.Tree, elements []*schema.Element) []*schema.Element {
+	tree.Range(func(name string, element *schema.Element) bool {
+		if element.Type != nil {
+			elements = append(elements, element)
+		} else if element.Children != nil {
+			elements = leafElements(element.Children, elements)
+		}
+		return true
+	})
+
+	return elements
+}
+
+// checkValueType returns error if value is not of Go type of parquetType as returned by Read.
+func checkValueType(parquetType parquet.Type, value interface{}) error {
+	ok := false
+	switch parquetType {
+	case parquet.Type_BOOLEAN:
+		_, ok = value.(bool)
+	case parquet.Type_INT32:
+		_, ok = value.(int32)
+	case parquet.Type_INT64:
+		_, ok = value.(int64)
+	case parquet.Type_FLOAT:
+		_, ok = value.(float32)
+	case parquet.Type_DOUBLE:
+		_, ok = value.(float64)
+	case parquet.Type_INT96, parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		_, ok = value.([]byte)
+	}
+
+	if !ok {
+		return fmt.Errorf("default value %v of type %T is not of %v type", value, value, parquetType)
+	}
+
+	return nil
+}
+
+// checkCompatible returns reason why file element is not readable as target element.
+func checkCompatible(fileElement, element *schema.Element) string {
+	fileType, targetType := *fileElement.Type, *element.Type
+	switch {
+	case fileType == targetType:
+	case fileType == parquet.Type_INT32 && targetType == parquet.Type_INT64:
+	case fileType == parquet.Type_FLOAT && targetType == parquet.Type_DOUBLE:
+	default:
+		return fmt.Sprintf("type %v of file column %v is not readable as %v", fileType, fileElement.PathInSchema, targetType)
+	}
+
+	if fileType == parquet.Type_FIXED_LEN_BYTE_ARRAY && fileElement.GetTypeLength() != element.GetTypeLength() {
+		return fmt.Sprintf("type length %v of file column %v differs from %v", fileElement.GetTypeLength(),
+			fileElement.PathInSchema, element.GetTypeLength())
+	}
+
+	if fileElement.MaxRepetitionLevel != element.MaxRepetitionLevel {
+		return fmt.Sprintf("repetition of file column %v differs", fileElement.PathInSchema)
+	}
+
+	if element.MaxDefinitionLevel == 0 && fileElement.MaxDefinitionLevel > 0 {
+		return fmt.Sprintf("file column %v is nullable", fileElement.PathInSchema)
+	}
+
+	return ""
+}
+
+func newProjection(fileTree, tree *schema.Tree, defaults map[string]interface{}) (*projection, error) {
+	// Copy of writable tree is converted, hence tree of the caller is not made read only.
+	if !tree.ReadOnly() {
+		tree = tree.Clone()
+	}
+
+	schemaList, valueElements, err := tree.ParquetSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	nested := make(map[string]nestedColumn)
+	findNestedColumns(tree, nested)
+	fileNested := make(map[string]nestedColumn)
+	findNestedColumns(fileTree, fileNested)
+
+	fileElements := leafElements(fileTree, nil)
+	fileByID := make(map[int32]*schema.Element)
+	fileByPath := make(map[string]*schema.Element)
+	for _, element := range fileElements {
+		if element.FieldID != nil {
+			fileByID[*element.FieldID] = element
+		}
+		fileByPath[element.PathInSchema] = element
+	}
+
+	for name := range defaults {
+		found := false
+		for _, element := range valueElements {
+			if found = element.PathInSchema == name; found {
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("default value column %v not found in target schema", name)
+		}
+	}
+
+	p := &projection{nameList: schemaPaths(schemaList)}
+	for _, element := range valueElements {
+		fileElement, found := (*schema.Element)(nil), false
+		if element.FieldID != nil {
+			fileElement, found = fileByID[*element.FieldID]
+		}
+		if !found {
+			// Column of the same path but of different field ID is a different column.
+			if fileElement, found = fileByPath[element.PathInSchema]; found &&
+				element.FieldID != nil && fileElement.FieldID != nil {
+				found = false
+			}
+		}
+
+		column := projectedColumn{element: element, name: element.PathInSchema, schema: &element.SchemaElement}
+		if nestedColumn, ok := nested[element.PathInSchema]; ok {
+			column.name = nestedColumn.name
+			column.schema = nestedColumn.schema
+			column.mapKey = nestedColumn.mapKey
+			column.mapValue = nestedColumn.mapValue
+		}
+
+		if found {
+			if reason := checkCompatible(fileElement, element); reason != "" {
+				return nil, &SchemaMismatchError{Column: element.PathInSchema, Reason: reason}
+			}
+
+			column.fileColumn = fileElement.PathInSchema
+			column.fileName = fileElement.PathInSchema
+			if nestedColumn, ok := fileNested[fileElement.PathInSchema]; ok {
+				column.fileName = nestedColumn.name
+				if nestedColumn.mapKey != column.mapKey || nestedColumn.mapValue != column.mapValue {
+					reason := fmt.Sprintf("file column %v is not in the same position of MAP group", fileElement.PathInSchema)
+					return nil, &SchemaMismatchError{Column: element.PathInSchema, Reason: reason}
+				}
+			}
+		} else if value, ok := defaults[element.PathInSchema]; ok {
+			if err = checkValueType(*element.Type, value); err != nil {
+				return nil, fmt.Errorf("column %v: %v", element.PathInSchema, err)
+			}
+			column.defaultValue = value
+		} else if element.MaxDefinitionLevel == 0 {
+			return nil, &SchemaMismatchError{Column: element.PathInSchema, Reason: "required column not found in file"}
+		}
+
+		p.columns = append(p.columns, column)
+	}
+
+	return p, nil
+}
+
+// fileColumns returns file columns read by projection.
+func (p *projection) fileColumns() set.StringSet {
+	columnNames := set.NewStringSet()
+	for _, column := range p.columns {
+		if column.fileColumn != "" {
+			columnNames.Add(column.fileColumn)
+		}
+	}
+
+	return columnNames
+}
+
+// widen returns value read as parquetType; INT32 and FLOAT values, also in lists and maps, are widened to
+// INT64 and DOUBLE.
+func widen(value interface{}, parquetType parquet.Type) interface{} {
+	switch v := value.(type) {
+	case int32:
+		if parquetType == parquet.Type_INT64 {
+			return int64(v)
+		}
+	case float32:
+		if parquetType == parquet.Type_DOUBLE {
+			return float64(v)
+		}
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i := range v {
+			list[i] = widen(v[i], parquetType)
+		}
+		return list
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[key] = widen(value, parquetType)
+		}
+		return m
+	}
+
+	return value
+}
+
+// project returns record of target schema from record of the file.
+func (p *projection) project(record *Record) *Record {
+	result := newRecord(p.nameList)
+	for _, column := range p.columns {
+		if column.mapValue {
+			// Values of MAP are set along with its keys; only widen them as per value column.
+			if value, ok := result.Get(column.name); ok && column.fileName != "" {
+				value.Value = widen(value.Value, *column.element.Type)
+				result.set(column.name, value)
+			}
+			continue
+		}
+
+		value := Value{
+			Value:  column.defaultValue,
+			Type:   *column.element.Type,
+			Schema: column.schema,
+		}
+
+		if column.fileName != "" {
+			fileValue, _ := record.Get(column.fileName)
+			if column.mapKey {
+				// Keys of MAP are strings.
+				value.Value = fileValue.Value
+			} else {
+				value.Value = widen(fileValue.Value, value.Type)
+			}
+		}
+
+		result.set(column.name, value)
+	}
+
+	return result
+}
+
+// SetTargetSchema - sets target schema tree to which records are projected by Read. Columns of tree are
+// mapped to columns of the file by field ID if both have one, otherwise by path. Columns missing in the
+// file are filled with value in defaults by path or null; INT32 and FLOAT columns are widened to INT64
+// and DOUBLE if required. *SchemaMismatchError is returned if the file is not readable as tree, i.e. a
+// required column is missing, type or repetition differs or nullable column is read as required.
+// Values of LIST and MAP groups are set in records by path of the group, as Read does without target schema.
+// Columns passed to NewReader are ignored. It must be called before Read. tree is not modified.
+func (reader *Reader) SetTargetSchema(tree *schema.Tree, defaults map[string]interface{}) error {
+	fileTree, err := reader.Schema()
+	if err != nil {
+		return err
+	}
+
+	p, err := newProjection(fileTree, tree, defaults)
+	if err != nil {
+		return err
+	}
+
+	columnNames := p.fileColumns()
+	if columnNames.IsEmpty() {
+		return errors.New("parquet: no column of target schema found in file")
+	}
+
+	reader.projection = p
+	reader.columnNames = columnNames
+	return nil
+}
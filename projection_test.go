@@ -0,0 +1,337 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+// writeProjectionFile writes file of columns id (INT32, field ID 1), score (FLOAT) and name (UTF8, field ID 3).
+func writeProjectionFile(t *testing.T) []byte {
+	plain := parquet.EncodingPtr(parquet.Encoding_PLAIN)
+
+	id, err := schema.NewElement("id", parquet.FieldRepetitionType_REQUIRED,
+		parquet.TypePtr(parquet.Type_INT32), nil, plain, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id.SetFieldID(1)
+
+	score, err := schema.NewElement("score", parquet.FieldRepetitionType_OPTIONAL,
+		parquet.TypePtr(parquet.Type_FLOAT), nil, plain, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := schema.NewElement("name", parquet.FieldRepetitionType_OPTIONAL,
+		parquet.TypePtr(parquet.Type_BYTE_ARRAY), parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8),
+		plain, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name.SetFieldID(3)
+
+	schemaTree := schema.NewTree()
+	for _, element := range []*schema.Element{id, score, name} {
+		if err = schemaTree.Set(element.Name, element); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, record := range []string{
+		`{"id": 1, "score": 1.5, "name": "foo"}`,
+		`{"id": 2, "score": 2.5, "name": "bar"}`,
+	} {
+		if err = writer.WriteJSON([]byte(record)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestReaderSetTargetSchema(t *testing.T) {
+	data := writeProjectionFile(t)
+
+	testCases := []struct {
+		schema         string
+		defaults       map[string]interface{}
+		expectedResult []string
+	}{
+		{
+			"message m { required int64 id; optional double score; optional binary name (UTF8); }",
+			nil,
+			[]string{"map[id:1 score:1.5 name:foo]", "map[id:2 score:2.5 name:bar]"},
+		},
+		// Renamed column is resolved by field ID; missing columns are null or default.
+		{
+			"message m { required int32 key = 1; optional int32 extra; optional binary city (UTF8); }",
+			map[string]interface{}{"extra": int32(7)},
+			[]string{"map[key:1 extra:7 city:<nil>]", "map[key:2 extra:7 city:<nil>]"},
+		},
+	}
+
+	for i, testCase := range testCases {
+		tree, err := schema.Parse(testCase.schema)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		reader, err := NewReader(bytesGetReaderFunc(data), nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if err = reader.SetTargetSchema(tree, testCase.defaults); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		var result []string
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("case %v: %v", i+1, err)
+			}
+
+			values := ""
+			record.Range(func(name string, value Value) bool {
+				if value.Type == parquet.Type_BYTE_ARRAY && value.Value != nil {
+					values += fmt.Sprintf(" %v:%s", name, value.Value)
+				} else {
+					values += fmt.Sprintf(" %v:%v", name, value.Value)
+				}
+				return true
+			})
+			result = append(result, "map["+values[1:]+"]")
+		}
+		reader.Close()
+
+		if fmt.Sprint(result) != fmt.Sprint(testCase.expectedResult) {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestReaderSetTargetSchemaWritableTree(t *testing.T) {
+	id, err := schema.NewElement("id", parquet.FieldRepetitionType_REQUIRED,
+		parquet.TypePtr(parquet.Type_INT64), nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := schema.NewTree()
+	if err = tree.Set("id", id); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewReader(bytesGetReaderFunc(writeProjectionFile(t)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if err = reader.SetTargetSchema(tree, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tree of the caller is neither made read only nor changed.
+	if tree.ReadOnly() || id.PathInSchema != "" {
+		t.Fatalf("tree: expected: writable and unchanged, got: read only: %v, id: %v", tree.ReadOnly(), id)
+	}
+
+	record, err := reader.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := record.Get("id"); value.Value != int64(1) {
+		t.Fatalf("id: expected: 1, got: %v", value.Value)
+	}
+}
+
+func TestReaderSetTargetSchemaErrors(t *testing.T) {
+	data := writeProjectionFile(t)
+
+	testCases := []struct {
+		schema         string
+		defaults       map[string]interface{}
+		expectErr      bool
+		expectMismatch bool
+	}{
+		{"message m { optional int32 id; optional float score; }", nil, false, false},
+		{"message m { required int32 id; required int32 missing; }", nil, true, true},                       // error: required column missing
+		{"message m { required int32 id; optional int64 name; }", nil, true, true},                          // error: type mismatch
+		{"message m { required int32 id; required float score; }", nil, true, true},                         // error: nullable as required
+		{"message m { required int32 id = 2; }", nil, true, true},                                           // error: different field ID
+		{"message m { optional int32 other; }", nil, true, false},                                           // error: no column in file
+		{"message m { required int32 id; optional int32 x; }", map[string]interface{}{"x": 1}, true, false}, // error: default of wrong type
+		{"message m { required int32 id; }", map[string]interface{}{"x": int32(1)}, true, false},            // error: default of unknown column
+	}
+
+	for i, testCase := range testCases {
+		tree, err := schema.Parse(testCase.schema)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		reader, err := NewReader(bytesGetReaderFunc(data), nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		err = reader.SetTargetSchema(tree, testCase.defaults)
+		expectErr := (err != nil)
+
+		if expectErr != testCase.expectErr {
+			if testCase.expectErr {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			} else {
+				t.Fatalf("case %v: err: expected: <nil>, got: %v", i+1, err)
+			}
+		}
+
+		var mismatchErr *SchemaMismatchError
+		if errors.As(err, &mismatchErr) != testCase.expectMismatch {
+			t.Fatalf("case %v: expected: *SchemaMismatchError: %v, got: %v", i+1, testCase.expectMismatch, err)
+		}
+	}
+}
+
+func TestReaderSetTargetSchemaNested(t *testing.T) {
+	fileTree, err := schema.Parse(`message m {
+  required int32 id;
+  optional group tags (LIST) { repeated group list { optional int32 element; } }
+  optional group attrs (MAP) { repeated group key_value { required binary key (UTF8); optional float value; } }
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, _ := fileTree.Get("attrs.key_value.key")
+	key.Encoding = parquet.EncodingPtr(parquet.Encoding_PLAIN)
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, fileTree, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, record := range []string{
+		`{"id": 1, "tags": [1, 2], "attrs": {"a": 1.5}}`,
+		`{"id": 2, "tags": [3], "attrs": {"b": 2.5, "c": 3.5}}`,
+	} {
+		if err = writer.WriteJSON([]byte(record)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		schema         string
+		expectedResult []string
+	}{
+		{
+			`message m {
+  optional group tags (LIST) { repeated group list { optional int32 element; } }
+  optional group attrs (MAP) { repeated group key_value { required binary key (UTF8); optional float value; } }
+}`,
+			[]string{"map[tags:[1 2] attrs:map[a:1.5]]", "map[tags:[3] attrs:map[b:2.5 c:3.5]]"},
+		},
+		// INT32 list element and FLOAT map value are widened.
+		{
+			`message m {
+  required int64 id;
+  optional group tags (LIST) { repeated group list { optional int64 element; } }
+  optional group attrs (MAP) { repeated group key_value { required binary key (UTF8); optional double value; } }
+}`,
+			[]string{"map[id:1 tags:[1 2] attrs:map[a:1.5]]", "map[id:2 tags:[3] attrs:map[b:2.5 c:3.5]]"},
+		},
+	}
+
+	for i, testCase := range testCases {
+		tree, err := schema.Parse(testCase.schema)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if err = reader.SetTargetSchema(tree, nil); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		var result []string
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("case %v: %v", i+1, err)
+			}
+
+			values := ""
+			record.Range(func(name string, value Value) bool {
+				values += fmt.Sprintf(" %v:%v", name, value.Value)
+				return true
+			})
+			result = append(result, "map["+values[1:]+"]")
+
+			if i == 1 {
+				tags, _ := record.Get("tags")
+				if _, ok := tags.Value.([]interface{})[0].(int64); !ok {
+					t.Fatalf("case %v: tags: expected: int64 elements, got: %T", i+1, tags.Value.([]interface{})[0])
+				}
+
+				attrs, _ := record.Get("attrs")
+				for _, value := range attrs.Value.(map[string]interface{}) {
+					if _, ok := value.(float64); !ok {
+						t.Fatalf("case %v: attrs: expected: float64 values, got: %T", i+1, value)
+					}
+				}
+			}
+		}
+		reader.Close()
+
+		if fmt.Sprint(result) != fmt.Sprint(testCase.expectedResult) {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
@@ -17,58 +17,353 @@
 package parquet
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"syscall"
+	"time"
 
-	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/parquet-go/bloom"
+	"github.com/minio/parquet-go/encryption"
 	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
 )
 
 // GetReaderFunc - function type returning io.ReadCloser for requested offset/length.
 type GetReaderFunc func(offset, length int64) (io.ReadCloser, error)
 
-func footerSize(getReaderFunc GetReaderFunc) (size int64, err error) {
-	rc, err := getReaderFunc(-8, 4)
-	if err != nil {
-		return 0, err
+// GetReaderFuncContext - is GetReaderFunc with context which cancels the request and reading of returned io.ReadCloser.
+type GetReaderFuncContext func(ctx context.Context, offset, length int64) (io.ReadCloser, error)
+
+func withContext(getReaderFunc GetReaderFunc) GetReaderFuncContext {
+	return func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+		return getReaderFunc(offset, length)
 	}
-	defer rc.Close()
+}
 
-	buf := make([]byte, 4)
-	if _, err = io.ReadFull(rc, buf); err != nil {
+// contextReadCloser - fails reads once current context is done.
+type contextReadCloser struct {
+	io.ReadCloser
+	ctx func() context.Context
+}
+
+func (rc *contextReadCloser) Read(p []byte) (n int, err error) {
+	if err = rc.ctx().Err(); err != nil {
 		return 0, err
 	}
 
-	size = int64(binary.LittleEndian.Uint32(buf))
+	return rc.ReadCloser.Read(p)
+}
+
+// bindContext returns GetReaderFunc requesting ranges with requestCtx. Once requestCtx or context returned by
+// ctx is done, no request is made; reads of returned readers fail once context returned by ctx at the time of
+// read is done.
+func bindContext(requestCtx context.Context, ctx func() context.Context, getReaderFunc GetReaderFuncContext) GetReaderFunc {
+	return func(offset, length int64) (io.ReadCloser, error) {
+		if err := ctx().Err(); err != nil {
+			return nil, err
+		}
+
+		if err := requestCtx.Err(); err != nil {
+			return nil, err
+		}
+
+		rc, err := getReaderFunc(requestCtx, offset, length)
+		if err != nil {
+			return nil, err
+		}
+
+		return &contextReadCloser{ReadCloser: rc, ctx: ctx}, nil
+	}
+}
+
+func staticContext(ctx context.Context) func() context.Context {
+	return func() context.Context { return ctx }
+}
 
-	return size, nil
+// detachedContext - has values of its parent but is never done.
+type detachedContext struct {
+	parent context.Context
 }
 
-func fileMetadata(getReaderFunc GetReaderFunc) (*parquet.FileMetaData, error) {
-	size, err := footerSize(getReaderFunc)
+func (ctx detachedContext) Deadline() (deadline time.Time, ok bool) { return }
+func (ctx detachedContext) Done() <-chan struct{}                   { return nil }
+func (ctx detachedContext) Err() error                              { return nil }
+func (ctx detachedContext) Value(key interface{}) interface{}       { return ctx.parent.Value(key) }
+
+func readFull(getReaderFunc GetReaderFunc, offset, length int64) ([]byte, error) {
+	rc, err := getReaderFunc(offset, length)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return readBytes(rc, length)
+}
+
+// readBufferSize is the size up to which readBytes allocates buffer before reading.
+const readBufferSize = 1 << 20
+
+// readBytes reads length bytes from reader. Buffer of length larger than readBufferSize grows as data is read,
+// hence corrupt length does not allocate memory beyond available data.
+func readBytes(reader io.Reader, length int64) ([]byte, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("negative length %v", length)
+	}
+
+	if length <= readBufferSize {
+		buf := make([]byte, length)
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+
+		return buf, nil
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, readBufferSize))
+	n, err := buf.ReadFrom(io.LimitReader(reader, length))
 	if err != nil {
 		return nil, err
 	}
+	if n != length {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return buf.Bytes(), nil
+}
 
-	rc, err := getReaderFunc(-(8 + size), size)
+// DefaultFooterReadSize - is default size of the file tail fetched by one request on opening a file; see
+// ReaderOptions.FooterReadSize.
+const DefaultFooterReadSize = 64 << 10
+
+// readTail reads last length bytes of the file. Shorter data is returned if the file is smaller than length.
+func readTail(getReaderFunc GetReaderFunc, length int64) ([]byte, error) {
+	rc, err := getReaderFunc(-length, length)
 	if err != nil {
 		return nil, err
 	}
 	defer rc.Close()
 
+	buf := make([]byte, length)
+	n, err := io.ReadFull(rc, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+
+	return buf[:n], nil
+}
+
+// isRangeError returns whether err is of range beyond the file, e.g. negative seek offset.
+func isRangeError(err error) bool {
+	return errors.Is(err, ErrInvalidRange) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.EINVAL)
+}
+
+// readFooter reads footer of the file in one request of tailSize bytes where possible and returns it with
+// its magic.
+func readFooter(getReaderFunc GetReaderFunc, tailSize int64, limits *Limits) (footer []byte, magic string, err error) {
+	if tailSize < 8 {
+		tailSize = 8
+	}
+
+	tail, err := readTail(getReaderFunc, tailSize)
+	if tailSize > 8 && isRangeError(err) {
+		// getReaderFunc may reject range beyond start of the file.
+		tailSize = 8
+		tail, err = readTail(getReaderFunc, tailSize)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Whole file is read if tail is shorter than requested.
+	wholeFile := int64(len(tail)) < tailSize
+	if len(tail) < 8 || (wholeFile && len(tail) < 12) {
+		return nil, "", fmt.Errorf("%w: file size %v is too small", ErrNotParquet, len(tail))
+	}
+
+	size := int64(binary.LittleEndian.Uint32(tail[len(tail)-8:]))
+	magic = string(tail[len(tail)-4:])
+	if magic != "PAR1" && magic != "PARE" {
+		return nil, "", fmt.Errorf("%w: invalid magic %q", ErrNotParquet, magic)
+	}
+
+	if wholeFile {
+		if header := string(tail[:4]); header != magic {
+			return nil, "", fmt.Errorf("%w: invalid header magic %q", ErrNotParquet, header)
+		}
+
+		if size > int64(len(tail))-12 {
+			return nil, "", fmt.Errorf("%w: footer size %v exceeds file size %v", ErrNotParquet, size, len(tail))
+		}
+	}
+
+	if err = limits.checkFooterSize(size); err != nil {
+		return nil, "", err
+	}
+
+	tail = tail[:len(tail)-8]
+	if size <= int64(len(tail)) {
+		return tail[int64(len(tail))-size:], magic, nil
+	}
+
+	rest, err := readFull(getReaderFunc, -(8 + size), size-int64(len(tail)))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return append(rest, tail...), magic, nil
+}
+
+// fileMetadata reads footer of the file by tail request of tailSize bytes. File decryptor is returned if the file
+// is encrypted and props is set.
+func fileMetadata(getReaderFunc GetReaderFunc, tailSize int64, props *FileDecryptionProperties, limits *Limits) (*parquet.FileMetaData, *fileDecryptor, error) {
+	buf, magic, err := readFooter(getReaderFunc, tailSize, limits)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	fileMeta := parquet.NewFileMetaData()
 
-	pf := thrift.NewTCompactProtocolFactory()
-	protocol := pf.GetProtocol(thrift.NewStreamTransportR(rc))
-	err = fileMeta.Read(context.Background(), protocol)
+	if magic == "PAR1" {
+		size, err := readThriftStruct(buf, fileMeta)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Plaintext columns of file with plaintext footer are readable without decryption properties.
+		if !fileMeta.IsSetEncryptionAlgorithm() || props == nil {
+			return fileMeta, nil, nil
+		}
+
+		decryptor, err := newFileDecryptor(props, fileMeta.EncryptionAlgorithm, fileMeta.FooterSigningKeyMetadata)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cipher, err := decryptor.footerCipher()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = cipher.Verify(buf[:size], buf[size:]); err != nil {
+			return nil, nil, err
+		}
+
+		if err = decryptor.decryptColumnMetaData(fileMeta); err != nil {
+			return nil, nil, err
+		}
+
+		return fileMeta, decryptor, nil
+	}
+
+	if props == nil {
+		return nil, nil, errors.New("file footer is encrypted; decryption properties not set")
+	}
+
+	cryptoMeta := parquet.NewFileCryptoMetaData()
+	cryptoMetaSize, err := readThriftStruct(buf, cryptoMeta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decryptor, err := newFileDecryptor(props, cryptoMeta.EncryptionAlgorithm, cryptoMeta.KeyMetadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cipher, err := decryptor.footerCipher()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if buf, err = cipher.Decrypt(encryption.Footer, 0, 0, 0, buf[cryptoMetaSize:]); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err = readThriftStruct(buf, fileMeta); err != nil {
+		return nil, nil, err
+	}
+
+	if err = decryptor.decryptColumnMetaData(fileMeta); err != nil {
+		return nil, nil, err
+	}
+
+	// Keep algorithm and footer key of the file so that its metadata is decryptable without crypto metadata.
+	fileMeta.EncryptionAlgorithm = cryptoMeta.EncryptionAlgorithm
+	fileMeta.FooterSigningKeyMetadata = cryptoMeta.KeyMetadata
+
+	return fileMeta, decryptor, nil
+}
+
+// readBloomFilter reads Bloom filter at offset. If cipher is set, header and bitset are encrypted modules.
+func readBloomFilter(getReaderFunc GetReaderFunc, offset int64, cipher *encryption.Cipher, rowGroupOrdinal, columnOrdinal int16) (*bloom.Filter, error) {
+	if cipher != nil {
+		readModule := func(offset int64) ([]byte, error) {
+			buf, err := readFull(getReaderFunc, offset, 4)
+			if err != nil {
+				return nil, err
+			}
+
+			length := int64(binary.LittleEndian.Uint32(buf))
+			if length > bloom.MaxBytes+bloom.HeaderProbeSize {
+				return nil, fmt.Errorf("invalid encrypted bloom filter module length %v", length)
+			}
+
+			return readFull(getReaderFunc, offset, 4+length)
+		}
+
+		headerModule, err := readModule(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		headerData, err := cipher.Decrypt(encryption.BloomFilterHeader, rowGroupOrdinal, columnOrdinal, 0, headerModule)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, _, err = bloom.ReadHeader(headerData); err != nil {
+			return nil, err
+		}
+
+		bitsetModule, err := readModule(offset + int64(len(headerModule)))
+		if err != nil {
+			return nil, err
+		}
+
+		bitset, err := cipher.Decrypt(encryption.BloomFilterBitset, rowGroupOrdinal, columnOrdinal, 0, bitsetModule)
+		if err != nil {
+			return nil, err
+		}
+
+		return bloom.NewFromBitset(bitset)
+	}
+
+	buf, err := readFull(getReaderFunc, offset, bloom.HeaderProbeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	header, headerSize, err := bloom.ReadHeader(buf)
 	if err != nil {
 		return nil, err
 	}
 
-	return fileMeta, nil
+	if header.NumBytes < bloom.MinBytes || header.NumBytes > bloom.MaxBytes {
+		return nil, fmt.Errorf("invalid bloom filter size %v", header.NumBytes)
+	}
+
+	bitset, err := readFull(getReaderFunc, offset+int64(headerSize), int64(header.NumBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return bloom.NewFromBitset(bitset)
 }
 
 // Value - denotes column value
@@ -85,70 +380,578 @@ func (value Value) MarshalJSON() (data []byte, err error) {
 
 // Reader - denotes parquet file.
 type Reader struct {
-	getReaderFunc  GetReaderFunc
+	VerifyChecksum bool   // Verifies CRC32 checksum of pages if available.
+	Lenient        bool   // Skips pages which are not readable; see SkippedPages.
+	FileLabel      string // Value of virtual column FileColumn; see SelectVirtualColumns.
+
+	getReaderFunc  GetReaderFuncContext
+	fileMeta       *parquet.FileMetaData
+	decryptor      *fileDecryptor
 	schemaElements []*parquet.SchemaElement
 	rowGroups      []*parquet.RowGroup
 	rowGroupIndex  int
+	rowGroupStart  int // Index of first row group read; see NewReaderForSplit.
+	rowGroupEnd    int // Index of row group after last row group read.
 
 	nameList    []string
 	columnNames set.StringSet
 	columns     map[string]*column
 	rowIndex    int64
+	seekRow     int64 // Row of current row group to be read first; see SeekToRow.
+
+	fileIdentity string // Cached identity of the file; see Position.
+
+	virtualColumns   []string // Virtual columns added to records; see SelectVirtualColumns.
+	rowGroupFirstRow int64    // Index of first row of current row group in the file.
+
+	equalityPredicates map[string][]uint64
+	projection         *projection
+	nestedColumns      map[string]nestedColumn
+	skippedPages       []error
+	limits             *Limits
+	closer             io.Closer          // Closes file opened by OpenFile.
+	ctx                context.Context    // Context of current ReadContext call.
+	readerCtx          context.Context    // Context of the reader till Close.
+	cancel             context.CancelFunc // Cancels readerCtx.
+	requestCtx         context.Context    // Context of column chunk requests; cancelled once context of a call is done.
+	cancelRequests     context.CancelFunc // Cancels requestCtx.
+}
+
+// context returns context of current ReadContext call.
+func (reader *Reader) context() context.Context {
+	if reader.ctx == nil {
+		return context.Background()
+	}
+
+	return reader.ctx
+}
+
+// ReaderOptions - denotes options of reader created by NewReaderWithOptions. Options may be combined; the zero
+// value reads all columns and row groups of plaintext file after reading its footer.
+type ReaderOptions struct {
+	Columns      set.StringSet             // Columns to be read; all columns are read if empty. See NewReader.
+	Decryption   *FileDecryptionProperties // If nil, only plaintext columns of files with plaintext footer are readable.
+	Limits       *Limits                   // Fails with ErrLimitExceeded on exceeding limits; see NewLimitedReader.
+	FileMetaData *parquet.FileMetaData     // Used instead of reading footer; see Reader.FileMetaData.
+	FooterCache  *FooterCache              // Caches file metadata of CacheKey if FileMetaData is nil; see FooterCache.
+	CacheKey     string                    // Identity of the file in FooterCache; must change whenever the file changes.
+	Split        *Split                    // Row groups to be read; all row groups are read if nil.
+	Context      context.Context           // Cancels reading the footer; its values are passed to requests of column chunks.
+
+	// FooterReadSize is size of the file tail fetched by one request on reading the footer; DefaultFooterReadSize
+	// is used if zero. If the footer is larger, rest of the footer is fetched by second request. If it is less
+	// than 8, footer length is fetched first.
+	FooterReadSize int64
 }
 
 // NewReader - creates new parquet reader. Reader calls getReaderFunc to get required data range for given columnNames. If columnNames is empty, all columns are used.
+// Column names are escaped string form of column paths; see schema.JoinPath.
 func NewReader(getReaderFunc GetReaderFunc, columnNames set.StringSet) (*Reader, error) {
-	fileMeta, err := fileMetadata(getReaderFunc)
-	if err != nil {
+	return NewReaderWithOptions(withContext(getReaderFunc), ReaderOptions{Columns: columnNames})
+}
+
+// NewEncryptedReader - creates new parquet reader decrypting the file as per props. If props is nil,
+// only plaintext columns of files with plaintext footer are readable.
+func NewEncryptedReader(getReaderFunc GetReaderFunc, columnNames set.StringSet, props *FileDecryptionProperties) (*Reader, error) {
+	return NewReaderWithOptions(withContext(getReaderFunc), ReaderOptions{Columns: columnNames, Decryption: props})
+}
+
+// NewLimitedReader - creates new parquet reader like NewEncryptedReader which fails with ErrLimitExceeded on
+// reading footer, schema, row groups or pages exceeding limits. If limits is nil, no limits are enforced.
+func NewLimitedReader(getReaderFunc GetReaderFunc, columnNames set.StringSet, props *FileDecryptionProperties, limits *Limits) (*Reader, error) {
+	return NewReaderWithOptions(withContext(getReaderFunc), ReaderOptions{Columns: columnNames, Decryption: props, Limits: limits})
+}
+
+// NewReaderContext - creates new parquet reader like NewReader. ctx cancels reading the footer; getReaderFunc is
+// called with context of the call requiring data range.
+func NewReaderContext(ctx context.Context, getReaderFunc GetReaderFuncContext, columnNames set.StringSet) (*Reader, error) {
+	return NewReaderWithOptions(getReaderFunc, ReaderOptions{Columns: columnNames, Context: ctx})
+}
+
+// NewReaderWithMetadata - creates new parquet reader of the file of fileMeta without reading its footer. fileMeta
+// is typically from Reader.FileMetaData, UnmarshalFileMetaData or FooterCache. Only plaintext columns are readable;
+// use NewReaderWithOptions with Decryption set to read encrypted columns.
+func NewReaderWithMetadata(getReaderFunc GetReaderFunc, fileMeta *parquet.FileMetaData, columnNames set.StringSet) (*Reader, error) {
+	if fileMeta == nil {
+		return nil, errors.New("parquet: file metadata without schema")
+	}
+
+	return NewReaderWithOptions(withContext(getReaderFunc), ReaderOptions{Columns: columnNames, FileMetaData: fileMeta})
+}
+
+// NewReaderWithOptions - creates new parquet reader as per opts. getReaderFunc is called with context of the
+// call requiring data range.
+//
+// If FileMetaData or file metadata of CacheKey in FooterCache is found, the footer is not read; the file is
+// decrypted as per Decryption and the metadata is checked against Limits like read footer. Otherwise the
+// footer is read and, if FooterCache is set, its metadata is added to FooterCache.
+func NewReaderWithOptions(getReaderFunc GetReaderFuncContext, opts ReaderOptions) (*Reader, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	footerReadSize := opts.FooterReadSize
+	if footerReadSize == 0 {
+		footerReadSize = DefaultFooterReadSize
+	}
+
+	fileMeta := opts.FileMetaData
+	if fileMeta == nil && opts.FooterCache != nil {
+		fileMeta, _ = opts.FooterCache.getDecrypted(opts.CacheKey, opts.Decryption)
+	}
+
+	var decryptor *fileDecryptor
+	var footerRead bool
+	var err error
+	switch {
+	case fileMeta == nil:
+		if fileMeta, decryptor, err = fileMetadata(bindContext(ctx, staticContext(ctx), getReaderFunc), footerReadSize, opts.Decryption, opts.Limits); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+		footerRead = true
+	case len(fileMeta.GetSchema()) == 0:
+		return nil, errors.New("parquet: file metadata without schema")
+	case fileMeta.IsSetEncryptionAlgorithm() && opts.Decryption != nil:
+		if decryptor, err = newFileDecryptor(opts.Decryption, fileMeta.EncryptionAlgorithm, fileMeta.FooterSigningKeyMetadata); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = opts.Limits.checkFileMetadata(fileMeta); err != nil {
 		return nil, err
 	}
 
-	nameList := []string{}
-	schemaElements := fileMeta.GetSchema()
-	for _, element := range schemaElements {
-		nameList = append(nameList, element.Name)
+	reader := newReader(ctx, getReaderFunc, fileMeta, decryptor, opts.Columns, opts.Limits)
+	if opts.Split != nil {
+		if err = reader.setSplit(*opts.Split); err != nil {
+			return nil, err
+		}
 	}
 
+	if footerRead && opts.FooterCache != nil {
+		opts.FooterCache.add(opts.CacheKey, fileMeta, opts.Decryption)
+	}
+
+	return reader, nil
+}
+
+// newReader creates reader requesting column chunks with context having values of ctx till the reader is closed.
+func newReader(ctx context.Context, getReaderFunc GetReaderFuncContext, fileMeta *parquet.FileMetaData, decryptor *fileDecryptor, columnNames set.StringSet, limits *Limits) *Reader {
+	schemaElements := fileMeta.GetSchema()
+	readerCtx, cancel := context.WithCancel(detachedContext{parent: ctx})
+	requestCtx, cancelRequests := context.WithCancel(readerCtx)
+
 	return &Reader{
 		getReaderFunc:  getReaderFunc,
+		fileMeta:       fileMeta,
+		decryptor:      decryptor,
 		rowGroups:      fileMeta.GetRowGroups(),
+		rowGroupEnd:    len(fileMeta.GetRowGroups()),
 		schemaElements: schemaElements,
-		nameList:       nameList,
+		nameList:       schemaPaths(schemaElements),
 		columnNames:    columnNames,
-	}, nil
+		nestedColumns:  newNestedColumns(schemaElements),
+		limits:         limits,
+		readerCtx:      readerCtx,
+		cancel:         cancel,
+		requestCtx:     requestCtx,
+		cancelRequests: cancelRequests,
+	}
+}
+
+// FileMetaData - returns metadata of the file which is shared by the reader, hence it must not be modified.
+// Column metadata is decrypted and, for file with encrypted footer, encryption algorithm and footer key metadata
+// are set, so the metadata is usable as ReaderOptions.FileMetaData with Decryption.
+func (reader *Reader) FileMetaData() *parquet.FileMetaData {
+	return reader.fileMeta
+}
+
+// KeyValueMetadata - returns key/value metadata of the file.
+func (reader *Reader) KeyValueMetadata() map[string]string {
+	keyValues := make(map[string]string)
+	for _, keyValue := range reader.fileMeta.GetKeyValueMetadata() {
+		keyValues[keyValue.Key] = keyValue.GetValue()
+	}
+
+	return keyValues
+}
+
+// columnChunk returns column chunk of column name in row group rowGroupIndex and its ordinal.
+func (reader *Reader) columnChunk(rowGroupIndex int, name string) (*parquet.ColumnChunk, int, error) {
+	if rowGroupIndex < 0 || rowGroupIndex >= len(reader.rowGroups) {
+		return nil, 0, fmt.Errorf("row group index %v out of range", rowGroupIndex)
+	}
+
+	for columnOrdinal, columnChunk := range reader.rowGroups[rowGroupIndex].GetColumns() {
+		if schema.JoinPath(columnPath(columnChunk)) == name {
+			return columnChunk, columnOrdinal, nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("column %v not found", name)
+}
+
+func (reader *Reader) columnMetaData(rowGroupIndex int, name string) (*parquet.ColumnMetaData, error) {
+	columnChunk, _, err := reader.columnChunk(rowGroupIndex, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !columnChunk.IsSetMetaData() {
+		if _, err = reader.decryptor.columnCipher(columnChunk); err != nil {
+			return nil, fmt.Errorf("column %v: %w", name, err)
+		}
+
+		return nil, fmt.Errorf("column %v metadata missing", name)
+	}
+
+	return columnChunk.GetMetaData(), nil
+}
+
+// ColumnKeyValueMetadata - returns key/value metadata of column chunk of column name in row group rowGroupIndex.
+func (reader *Reader) ColumnKeyValueMetadata(rowGroupIndex int, name string) (map[string]string, error) {
+	meta, err := reader.columnMetaData(rowGroupIndex, name)
+	if err != nil {
+		return nil, err
+	}
+
+	keyValues := make(map[string]string)
+	for _, keyValue := range meta.GetKeyValueMetadata() {
+		keyValues[keyValue.Key] = keyValue.GetValue()
+	}
+
+	return keyValues, nil
+}
+
+// BloomFilter - returns Bloom filter of column chunk of column name in row group rowGroupIndex. It returns nil if column chunk has no Bloom filter.
+func (reader *Reader) BloomFilter(rowGroupIndex int, name string) (*bloom.Filter, error) {
+	return reader.bloomFilter(context.Background(), rowGroupIndex, name)
+}
+
+func (reader *Reader) bloomFilter(ctx context.Context, rowGroupIndex int, name string) (*bloom.Filter, error) {
+	meta, err := reader.columnMetaData(rowGroupIndex, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !meta.IsSetBloomFilterOffset() {
+		return nil, nil
+	}
+
+	columnChunk, columnOrdinal, err := reader.columnChunk(rowGroupIndex, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var cipher *encryption.Cipher
+	if columnChunk.IsSetCryptoMetadata() {
+		if cipher, err = reader.decryptor.columnCipher(columnChunk); err != nil {
+			return nil, fmt.Errorf("column %v: %w", name, err)
+		}
+	}
+
+	return readBloomFilter(bindContext(ctx, staticContext(ctx), reader.getReaderFunc), meta.GetBloomFilterOffset(), cipher, int16(rowGroupIndex), int16(columnOrdinal))
+}
+
+// SetEqualityPredicate - sets predicate column name = values[0] OR ... OR values[n-1]. Read skips row groups
+// whose Bloom filter of column name contains none of values; rows of other row groups are not filtered.
+// Values must be of Go type of column's physical type i.e. int32, int64, float32, float64, []byte or string.
+// Predicates of different columns are combined with AND; setting no values removes predicate of the column.
+func (reader *Reader) SetEqualityPredicate(name string, values ...interface{}) error {
+	if len(values) == 0 {
+		delete(reader.equalityPredicates, name)
+		return nil
+	}
+
+	hashes := make([]uint64, 0, len(values))
+	for _, value := range values {
+		hash, err := bloom.Hash(value)
+		if err != nil {
+			return err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if reader.equalityPredicates == nil {
+		reader.equalityPredicates = make(map[string][]uint64)
+	}
+	reader.equalityPredicates[name] = hashes
+	return nil
+}
+
+// skipRowGroup returns whether Bloom filters prove that row group rowGroupIndex does not satisfy equality predicates.
+func (reader *Reader) skipRowGroup(ctx context.Context, rowGroupIndex int) (bool, error) {
+	for name, hashes := range reader.equalityPredicates {
+		filter, err := reader.bloomFilter(ctx, rowGroupIndex, name)
+		if err != nil {
+			return false, err
+		}
+
+		if filter == nil {
+			continue
+		}
+
+		found := false
+		for _, hash := range hashes {
+			if found = filter.Check(hash); found {
+				break
+			}
+		}
+
+		if !found {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Schema - returns schema tree of the file. Returned tree may be used to create Writer of the same schema.
+func (reader *Reader) Schema() (*schema.Tree, error) {
+	return schema.FromParquetSchema(reader.schemaElements)
+}
+
+// ColumnNameByFieldID - returns path of column whose field ID is fieldID. It resolves columns renamed
+// since the file was written if writers preserve field IDs.
+func (reader *Reader) ColumnNameByFieldID(fieldID int32) (string, error) {
+	tree, err := reader.Schema()
+	if err != nil {
+		return "", err
+	}
+
+	for _, element := range leafElements(tree, nil) {
+		if element.FieldID != nil && *element.FieldID == fieldID {
+			return element.PathInSchema, nil
+		}
+	}
+
+	return "", fmt.Errorf("column of field ID %v not found", fieldID)
+}
+
+// SelectColumnsByFieldID - sets columns to be read by Read to columns of fieldIDs. It must be called before Read.
+func (reader *Reader) SelectColumnsByFieldID(fieldIDs ...int32) error {
+	columnNames := set.NewStringSet()
+	for _, fieldID := range fieldIDs {
+		name, err := reader.ColumnNameByFieldID(fieldID)
+		if err != nil {
+			return err
+		}
+		columnNames.Add(name)
+	}
+
+	reader.columnNames = columnNames
+	return nil
+}
+
+// SelectColumns - sets columns to be read by Read to columns of paths. Unlike column names passed to
+// NewReader, names in paths are not escaped, hence names containing '.' are unambiguous. It must be
+// called before Read.
+func (reader *Reader) SelectColumns(paths ...[]string) {
+	columnNames := set.NewStringSet()
+	for _, path := range paths {
+		columnNames.Add(schema.JoinPath(path))
+	}
+
+	reader.columnNames = columnNames
+}
+
+// CreatedBy - returns application which wrote the file.
+func (reader *Reader) CreatedBy() string {
+	return reader.fileMeta.GetCreatedBy()
+}
+
+// firstRow returns index of first row of row group rowGroupIndex in the file.
+func (reader *Reader) firstRow(rowGroupIndex int) (row int64) {
+	for _, rowGroup := range reader.rowGroups[:rowGroupIndex] {
+		row += rowGroup.GetNumRows()
+	}
+
+	return row
+}
+
+// SkippedPages - returns errors of pages skipped by Read in lenient mode. Values of rows of skipped pages
+// are null; if number of rows of a skipped page is unknown, rest of its column chunk is null. Errors are
+// *PageError, *ChecksumError or *DecryptionError.
+func (reader *Reader) SkippedPages() []error {
+	return reader.skippedPages
 }
 
-// Read - reads single record.
+// Read - reads single record. Errors of pages are returned as *PageError, *ChecksumError or
+// *DecryptionError unless Lenient is set.
 func (reader *Reader) Read() (record *Record, err error) {
-	if reader.rowGroupIndex >= len(reader.rowGroups) {
+	return reader.ReadContext(context.Background())
+}
+
+// ReadContext - reads single record like Read. Once ctx is done, ctx.Err() is returned and no more data is
+// requested or decoded. Column chunks are requested with context having values of context of the reader
+// constructor, hence they are read by following calls till end of the row group regardless of ctx of the
+// call requesting them. Once ctx is done, in-flight column chunk requests are cancelled and the chunks are
+// requested again by next call from the record being read.
+func (reader *Reader) ReadContext(ctx context.Context) (record *Record, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	reader.ctx = ctx
+	stop := reader.cancelRequestsOnDone(ctx)
+	defer func() {
+		stop()
+		reader.ctx = nil
+
+		if ctx.Err() != nil {
+			reader.cancelRequests()
+		}
+
+		if reader.requestCtx.Err() != nil && reader.readerCtx.Err() == nil {
+			reader.reopenColumns()
+		}
+	}()
+
+	if record, err = reader.read(ctx); err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	return record, err
+}
+
+// cancelRequestsOnDone cancels column chunk requests once ctx is done till returned function is called.
+func (reader *Reader) cancelRequestsOnDone(ctx context.Context) (stop func()) {
+	if ctx.Done() == nil {
+		return func() {}
+	}
+
+	stopCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	go func(cancelRequests context.CancelFunc) {
+		defer close(doneCh)
+
+		select {
+		case <-ctx.Done():
+			cancelRequests()
+		case <-stopCh:
+		}
+	}(reader.cancelRequests)
+
+	return func() {
+		close(stopCh)
+		<-doneCh
+	}
+}
+
+// reopenColumns closes columns whose requests are cancelled and renews context of column chunk requests,
+// hence next read requests column chunks again from current row.
+func (reader *Reader) reopenColumns() {
+	reader.requestCtx, reader.cancelRequests = context.WithCancel(reader.readerCtx)
+	if reader.columns == nil {
+		return
+	}
+
+	seekRow := reader.rowIndex
+	reader.closeColumns()
+	if seekRow < reader.rowGroups[reader.rowGroupIndex].GetNumRows() {
+		reader.seekRow = seekRow
+	} else {
+		reader.rowGroupIndex++
+	}
+}
+
+func (reader *Reader) read(ctx context.Context) (record *Record, err error) {
+	if reader.rowGroupIndex >= reader.rowGroupEnd {
 		return nil, io.EOF
 	}
 
 	if reader.columns == nil {
+		for ; reader.rowGroupIndex < reader.rowGroupEnd; reader.rowGroupIndex++ {
+			skip, err := reader.skipRowGroup(ctx, reader.rowGroupIndex)
+			if err != nil {
+				return nil, err
+			}
+
+			if !skip {
+				break
+			}
+			reader.seekRow = 0
+		}
+
+		if reader.rowGroupIndex >= reader.rowGroupEnd {
+			return nil, io.EOF
+		}
+
+		reader.rowGroupFirstRow = reader.firstRow(reader.rowGroupIndex)
 		reader.columns, err = getColumns(
 			reader.rowGroups[reader.rowGroupIndex],
-			reader.columnNames,
-			reader.schemaElements,
-			reader.getReaderFunc,
+			reader.rowGroupIndex,
+			reader.seekRow,
+			reader.rowGroupFirstRow,
+			&columnOptions{
+				columnNames:    reader.columnNames,
+				schemaElements: reader.schemaElements,
+				getReaderFunc:  bindContext(reader.requestCtx, reader.context, reader.getReaderFunc),
+				verifyChecksum: reader.VerifyChecksum,
+				decryptor:      reader.decryptor,
+				lenient:        reader.Lenient,
+				limits:         reader.limits,
+				legacyLayout:   !reader.fileMeta.IsSetCreatedBy(),
+			},
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		reader.rowIndex = 0
+		reader.rowIndex = reader.seekRow
+		reader.seekRow = 0
 	}
 
 	if reader.rowIndex >= reader.rowGroups[reader.rowGroupIndex].GetNumRows() {
 		reader.rowGroupIndex++
-		reader.Close()
-		return reader.Read()
+		reader.closeColumns()
+		return reader.read(ctx)
 	}
 
 	record = newRecord(reader.nameList)
+	mapKeys := make(map[string]Value)
+	mapValues := make(map[string]interface{})
 	for name := range reader.columns {
 		col := reader.columns[name]
-		value, valueType, schema := col.read()
-		record.set(name, Value{Value: value, Type: valueType, Schema: schema})
+		value, valueType, schema, err := col.read(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(col.skipped) > 0 {
+			reader.skippedPages = append(reader.skippedPages, col.skipped...)
+			col.skipped = nil
+		}
+
+		// Values of LIST and MAP groups are surfaced as list and map of the group.
+		nested, found := reader.nestedColumns[name]
+		switch {
+		case !found:
+			record.set(name, Value{Value: value, Type: valueType, Schema: schema})
+		case nested.mapKey:
+			mapKeys[nested.name] = Value{Value: value, Type: valueType, Schema: nested.schema}
+		case nested.mapValue:
+			mapValues[nested.name] = value
+		default:
+			record.set(nested.name, Value{Value: value, Type: valueType, Schema: nested.schema})
+		}
+	}
+
+	for name, keys := range mapKeys {
+		keys.Value = mapOf(keys.Value, mapValues[name])
+		record.set(name, keys)
+	}
+
+	if reader.projection != nil {
+		record = reader.projection.project(record)
+	}
+
+	if len(reader.virtualColumns) > 0 {
+		reader.setVirtualColumns(record, reader.rowIndex)
 	}
 
 	reader.rowIndex++
@@ -156,14 +959,25 @@ func (reader *Reader) Read() (record *Record, err error) {
 	return record, nil
 }
 
-// Close - closes underneath readers.
-func (reader *Reader) Close() (err error) {
+// closeColumns closes readers of columns of current row group.
+func (reader *Reader) closeColumns() {
 	for _, column := range reader.columns {
 		column.close()
 	}
 
 	reader.columns = nil
 	reader.rowIndex = 0
+}
 
-	return nil
+// Close - closes underneath readers and file opened by OpenFile, and cancels requests of column chunks.
+func (reader *Reader) Close() (err error) {
+	reader.closeColumns()
+	reader.cancel()
+
+	if reader.closer != nil {
+		err = reader.closer.Close()
+		reader.closer = nil
+	}
+
+	return err
 }
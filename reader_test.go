@@ -17,11 +17,16 @@
 package parquet
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"testing"
 
 	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
 )
 
 func getReader(name string, offset int64, length int64) (io.ReadCloser, error) {
@@ -88,3 +93,489 @@ func TestReader(t *testing.T) {
 
 	reader.Close()
 }
+
+func TestReaderVerifyChecksum(t *testing.T) {
+	schemaTree := schema.NewTree()
+	{
+		one, err := schema.NewElement("one", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := schemaTree.Set("one", one); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.PageChecksum = true
+
+	for i := 0; i < 10; i++ {
+		if err = writer.WriteJSON([]byte(fmt.Sprintf(`{"one": %v}`, i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	readAll := func(data []byte) error {
+		reader, err := NewReader(bytesGetReaderFunc(data), nil)
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		reader.VerifyChecksum = true
+
+		for {
+			if _, err = reader.Read(); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+
+				return err
+			}
+		}
+	}
+
+	fileData := buf.Bytes()
+	if err = readAll(fileData); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewReader(bytesGetReaderFunc(fileData), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := reader.rowGroups[0].Columns[0].MetaData
+	pageOffset := meta.GetDataPageOffset()
+	chunkEnd := meta.GetDictionaryPageOffset() + meta.GetTotalCompressedSize()
+
+	corruptedData := append([]byte{}, fileData...)
+	corruptedData[chunkEnd-1] ^= 0xFF
+
+	err = readAll(corruptedData)
+	checksumErr, ok := err.(*ChecksumError)
+	if !ok {
+		t.Fatalf("expected: *ChecksumError, got: %v", err)
+	}
+
+	if checksumErr.Column != "one" || checksumErr.RowGroup != 0 || checksumErr.PageOffset != pageOffset {
+		t.Fatalf("unexpected checksum error %v", checksumErr)
+	}
+}
+
+func TestReaderBloomFilter(t *testing.T) {
+	schemaTree := schema.NewTree()
+	{
+		id, err := schema.NewElement("id", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_INT64), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id.BloomFilter = true
+
+		if err := schemaTree.Set("id", id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 30; i++ {
+		if err = writer.WriteJSON([]byte(fmt.Sprintf(`{"id": %v}`, i*1000))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		values        []interface{}
+		expectedCount int
+	}{
+		{nil, 30},
+		{[]interface{}{int64(15000)}, 10},
+		{[]interface{}{int64(5000), int64(25000)}, 20},
+		{[]interface{}{int64(30000)}, 0},
+	}
+
+	for i, testCase := range testCases {
+		reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		for rowGroupIndex := 0; rowGroupIndex < 3; rowGroupIndex++ {
+			filter, err := reader.BloomFilter(rowGroupIndex, "id")
+			if err != nil {
+				t.Fatalf("case %v: %v", i+1, err)
+			}
+			if filter == nil {
+				t.Fatalf("case %v: row group %v: expected: <filter>, got: <nil>", i+1, rowGroupIndex)
+			}
+		}
+
+		if err = reader.SetEqualityPredicate("id", testCase.values...); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		count := 0
+		for {
+			if _, err = reader.Read(); err != nil {
+				if err != io.EOF {
+					t.Fatalf("case %v: %v", i+1, err)
+				}
+				break
+			}
+			count++
+		}
+		reader.Close()
+
+		if count != testCase.expectedCount {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedCount, count)
+		}
+	}
+}
+
+func TestReaderSchema(t *testing.T) {
+	schemaTree := schema.NewTree()
+	{
+		id, err := schema.NewElement("id", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_INT64), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		name, err := schema.NewElement("name", parquet.FieldRepetitionType_OPTIONAL,
+			parquet.TypePtr(parquet.Type_BYTE_ARRAY), parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8),
+			nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := schemaTree.Set("id", id); err != nil {
+			t.Fatal(err)
+		}
+		if err := schemaTree.Set("name", name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.WriteJSON([]byte(`{"id": 1, "name": "foo"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	tree, err := reader.Schema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, ok := tree.Get("name")
+	if !ok {
+		t.Fatalf("name: not found")
+	}
+
+	if name.MaxDefinitionLevel != 1 || name.PathInSchema != "name" {
+		t.Fatalf("name: unexpected element %v", name)
+	}
+
+	// Schema of the file is reusable by new writer.
+	writer, err = NewWriter(new(bufferWriteCloser), tree, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.WriteJSON([]byte(`{"id": 2, "name": "bar"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReaderFieldID(t *testing.T) {
+	reader, err := NewReader(bytesGetReaderFunc(writeProjectionFile(t)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	tree, err := reader.Schema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id, _ := tree.Get("id"); id.GetFieldID() != 1 {
+		t.Fatalf("expected: 1, got: %v", id.GetFieldID())
+	}
+
+	testCases := []struct {
+		fieldID        int32
+		expectedResult string
+		expectErr      bool
+	}{
+		{1, "id", false},
+		{3, "name", false},
+		{2, "", true},
+	}
+
+	for i, testCase := range testCases {
+		result, err := reader.ColumnNameByFieldID(testCase.fieldID)
+		expectErr := (err != nil)
+
+		if expectErr != testCase.expectErr {
+			if testCase.expectErr {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			} else {
+				t.Fatalf("case %v: err: expected: <nil>, got: %v", i+1, err)
+			}
+		}
+
+		if result != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+
+	if err = reader.SelectColumnsByFieldID(3); err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := reader.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found := record.Get("id"); found {
+		t.Fatalf("id: expected: not found, got: found")
+	}
+
+	if value, _ := record.Get("name"); string(value.Value.([]byte)) != "foo" {
+		t.Fatalf("name: expected: foo, got: %v", value.Value)
+	}
+}
+
+func TestReaderSelectColumns(t *testing.T) {
+	plain := parquet.EncodingPtr(parquet.Encoding_PLAIN)
+
+	dotted, err := schema.NewElement("a.b", parquet.FieldRepetitionType_REQUIRED,
+		parquet.TypePtr(parquet.Type_INT32), nil, plain, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unicode, err := schema.NewElement("名前", parquet.FieldRepetitionType_OPTIONAL,
+		parquet.TypePtr(parquet.Type_BYTE_ARRAY), parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8), plain, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := schema.NewElement("g h", parquet.FieldRepetitionType_REQUIRED, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	child, err := schema.NewElement("x.y", parquet.FieldRepetitionType_REQUIRED,
+		parquet.TypePtr(parquet.Type_INT32), nil, plain, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaTree := schema.NewTree()
+	if err = schemaTree.SetPath([]string{"a.b"}, dotted); err != nil {
+		t.Fatal(err)
+	}
+	if err = schemaTree.SetPath([]string{"名前"}, unicode); err != nil {
+		t.Fatal(err)
+	}
+	if err = schemaTree.SetPath([]string{"g h"}, group); err != nil {
+		t.Fatal(err)
+	}
+	if err = schemaTree.SetPath([]string{"g h", "x.y"}, child); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.WriteJSON([]byte(`{"a.b": 1, "名前": "foo", "g h": {"x.y": 2}}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	reader.SelectColumns([]string{"a.b"}, []string{"g h", "x.y"})
+
+	record, err := reader.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value, _ := record.GetPath([]string{"a.b"}); value.Value != int32(1) {
+		t.Fatalf("a.b: expected: 1, got: %v", value.Value)
+	}
+
+	if value, _ := record.GetPath([]string{"g h", "x.y"}); value.Value != int32(2) {
+		t.Fatalf("g h.x.y: expected: 2, got: %v", value.Value)
+	}
+
+	if _, found := record.GetPath([]string{"名前"}); found {
+		t.Fatalf("名前: expected: not found, got: found")
+	}
+
+	if _, found := record.GetPath([]string{"a", "b"}); found {
+		t.Fatalf("a.b: expected: not found, got: found")
+	}
+}
+
+func TestReaderFooterRequests(t *testing.T) {
+	data := writePageErrorFile(t)
+
+	testCases := []struct {
+		footerReadSize   int64
+		rejectBeyondFile bool // Whether getReaderFunc rejects range beyond start of the file.
+		expectedRequests int
+		expectErr        bool
+	}{
+		{0, false, 1, false},
+		{64 << 10, false, 1, false},
+		{int64(len(data)), false, 1, false},
+		{16, false, 2, false},
+		{1, false, 2, false},
+		{64 << 10, true, 3, false},
+		{16, true, 1, true}, // error: other than invalid range is not retried.
+	}
+
+	for i, testCase := range testCases {
+		var requests int
+		getReaderFunc := func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+			requests++
+			if testCase.rejectBeyondFile && -offset > int64(len(data)) {
+				return nil, fmt.Errorf("%w: offset out of range", ErrInvalidRange)
+			}
+			if testCase.expectErr {
+				return nil, errors.New("connection reset")
+			}
+
+			return bytesGetReaderFunc(data)(offset, length)
+		}
+
+		reader, err := NewReaderWithOptions(getReaderFunc, ReaderOptions{FooterReadSize: testCase.footerReadSize})
+		if expectErr := (err != nil); expectErr != testCase.expectErr {
+			t.Fatalf("case %v: err: expected: %v, got: %v", i+1, testCase.expectErr, err)
+		}
+		if err == nil {
+			reader.Close()
+		}
+
+		if requests != testCase.expectedRequests {
+			t.Fatalf("case %v: requests: expected: %v, got: %v", i+1, testCase.expectedRequests, requests)
+		}
+	}
+}
+
+func TestReaderNotParquet(t *testing.T) {
+	data := writePageErrorFile(t)
+
+	testCases := [][]byte{
+		nil,
+		[]byte("PAR1"),
+		[]byte("PAR1PAR1PAR"),
+		[]byte("this is not a parquet file"),
+		[]byte("PAR1\xff\xff\xff\x7fPAR1"),
+		append([]byte("PARE"), data[4:]...),
+	}
+
+	for i, testCase := range testCases {
+		if _, err := NewReader(bytesGetReaderFunc(testCase), nil); !errors.Is(err, ErrNotParquet) {
+			t.Fatalf("case %v: err: expected: %v, got: %v", i+1, ErrNotParquet, err)
+		}
+	}
+}
+
+func TestReaderLegacyLayout(t *testing.T) {
+	// testdata/legacy.parquet is written by earlier release without created_by. Column 'a' and optional 'd' are
+	// dictionary encoded, optional 'b' and 'c' are PLAIN encoded in data page v2.
+	name := "testdata/legacy.parquet"
+	reader, err := NewReader(
+		func(offset, length int64) (io.ReadCloser, error) {
+			return getReader(name, offset, length)
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if reader.FileMetaData().IsSetCreatedBy() {
+		t.Fatalf("created by: expected: unset, got: %v", reader.CreatedBy())
+	}
+
+	var result []string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var values []interface{}
+		for _, name := range []string{"a", "b", "c", "d"} {
+			value, _ := record.Get(name)
+			if v, ok := value.Value.([]byte); ok {
+				values = append(values, string(v))
+			} else {
+				values = append(values, value.Value)
+			}
+		}
+		result = append(result, fmt.Sprint(values))
+	}
+
+	expected := "[[1 <nil> foo <nil>] [2 20 bar 7] [3 30 baz 8] [2 40 qux 7] [3 50 quux 8]]"
+	if fmt.Sprint(result) != expected {
+		t.Fatalf("expected: %v, got: %v", expected, result)
+	}
+}
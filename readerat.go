@@ -0,0 +1,140 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+)
+
+// clampRange returns range of offset and length within size bytes. Negative offset is from the end and range
+// beyond start of the data is clamped like suffix range request of object storage.
+func clampRange(offset, length, size int64) (int64, int64, error) {
+	if offset < 0 {
+		if offset += size; offset < 0 {
+			length += offset
+			offset = 0
+		}
+	}
+
+	if offset > size || length < 0 {
+		return 0, 0, fmt.Errorf("%w: range %v-%v out of size %v", ErrInvalidRange, offset, offset+length, size)
+	}
+
+	if length > size-offset {
+		length = size - offset
+	}
+
+	return offset, length, nil
+}
+
+// readerAtGetReaderFunc returns GetReaderFunc of section readers of r of size bytes. Closing returned readers
+// does not close r.
+func readerAtGetReaderFunc(r io.ReaderAt, size int64) GetReaderFunc {
+	return func(offset, length int64) (io.ReadCloser, error) {
+		offset, length, err := clampRange(offset, length, size)
+		if err != nil {
+			return nil, err
+		}
+
+		return ioutil.NopCloser(io.NewSectionReader(r, offset, length)), nil
+	}
+}
+
+// bytesGetReaderFunc returns GetReaderFunc of readers of ranges of data without copying.
+func bytesGetReaderFunc(data []byte) GetReaderFunc {
+	return func(offset, length int64) (io.ReadCloser, error) {
+		offset, length, err := clampRange(offset, length, int64(len(data)))
+		if err != nil {
+			return nil, err
+		}
+
+		return ioutil.NopCloser(bytes.NewReader(data[offset : offset+length])), nil
+	}
+}
+
+// NewReaderAt - creates new parquet reader of r of size bytes. Reader does not close r.
+func NewReaderAt(r io.ReaderAt, size int64, columnNames set.StringSet) (*Reader, error) {
+	return NewReader(readerAtGetReaderFunc(r, size), columnNames)
+}
+
+// NewFileReader - creates new parquet reader of file. Reader does not close file.
+func NewFileReader(file *os.File, columnNames set.StringSet) (*Reader, error) {
+	fi, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReaderAt(file, fi.Size(), columnNames)
+}
+
+// NewBytesReader - creates new parquet reader of data. Data must not be modified while reader is in use.
+func NewBytesReader(data []byte, columnNames set.StringSet) (*Reader, error) {
+	return NewReader(bytesGetReaderFunc(data), columnNames)
+}
+
+// OpenFile - opens named file and creates new parquet reader of it. If mmap is set, the file is memory mapped
+// on Linux and read by NewFileReader elsewhere. Reader.Close closes or unmaps the file.
+func OpenFile(name string, columnNames set.StringSet, mmap bool) (*Reader, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if mmap {
+		data, unmap, err := mmapFile(file)
+		if err == nil {
+			file.Close()
+
+			reader, err := NewBytesReader(data, columnNames)
+			if err != nil {
+				unmap()
+				return nil, err
+			}
+
+			reader.closer = closerFunc(unmap)
+			return reader, nil
+		}
+
+		if !errors.Is(err, errMmapUnsupported) {
+			file.Close()
+			return nil, err
+		}
+	}
+
+	reader, err := NewFileReader(file, columnNames)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	reader.closer = file
+	return reader, nil
+}
+
+// closerFunc - adapts function to io.Closer.
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
@@ -0,0 +1,135 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClampRange(t *testing.T) {
+	testCases := []struct {
+		offset         int64
+		length         int64
+		expectedOffset int64
+		expectedLength int64
+		expectErr      bool
+	}{
+		{0, 10, 0, 10, false},
+		{90, 20, 90, 10, false},
+		{-8, 8, 92, 8, false},
+		{-200, 200, 0, 100, false},
+		{100, 0, 100, 0, false},
+		{101, 1, 0, 0, true},
+		{0, -1, 0, 0, true},
+	}
+
+	for i, testCase := range testCases {
+		offset, length, err := clampRange(testCase.offset, testCase.length, 100)
+		if testCase.expectErr {
+			if err == nil {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if offset != testCase.expectedOffset || length != testCase.expectedLength {
+			t.Fatalf("case %v: expected: %v-%v, got: %v-%v", i+1, testCase.expectedOffset, testCase.expectedLength, offset, length)
+		}
+	}
+}
+
+func TestReaderConstructors(t *testing.T) {
+	data := writePageErrorFile(t)
+
+	dir, err := ioutil.TempDir("", "parquet-go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "test.parquet")
+	if err = ioutil.WriteFile(name, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	testCases := []struct {
+		newReader  func() (*Reader, error)
+		closesFile bool
+	}{
+		{func() (*Reader, error) { return NewReaderAt(bytes.NewReader(data), int64(len(data)), nil) }, false},
+		{func() (*Reader, error) { return NewFileReader(file, nil) }, false},
+		{func() (*Reader, error) { return NewBytesReader(data, nil) }, false},
+		{func() (*Reader, error) { return OpenFile(name, nil, false) }, true},
+		{func() (*Reader, error) { return OpenFile(name, nil, true) }, false},
+	}
+
+	for i, testCase := range testCases {
+		reader, err := testCase.newReader()
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if result := readColumnA(t, reader); result != "[1 2 3 4]" {
+			t.Fatalf("case %v: expected: [1 2 3 4], got: %v", i+1, result)
+		}
+
+		openedFile, _ := reader.closer.(*os.File)
+		if err = reader.Close(); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if testCase.closesFile {
+			if openedFile == nil || !errors.Is(openedFile.Close(), os.ErrClosed) {
+				t.Fatalf("case %v: expected: file closed", i+1)
+			}
+		}
+	}
+
+	// File passed to NewFileReader is usable after closing the reader.
+	if _, err = file.Stat(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = OpenFile(filepath.Join(dir, "missing.parquet"), nil, false); err == nil {
+		t.Fatalf("err: expected: <error>, got: <nil>")
+	}
+
+	notParquet := filepath.Join(dir, "not.parquet")
+	if err = ioutil.WriteFile(notParquet, []byte("not a parquet file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, mmap := range []bool{false, true} {
+		if _, err = OpenFile(notParquet, nil, mmap); !errors.Is(err, ErrNotParquet) {
+			t.Fatalf("mmap %v: err: expected: %v, got: %v", mmap, ErrNotParquet, err)
+		}
+	}
+}
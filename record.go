@@ -19,6 +19,8 @@ package parquet
 import (
 	"fmt"
 	"strings"
+
+	"github.com/minio/parquet-go/schema"
 )
 
 // Record - ordered parquet record.
@@ -48,6 +50,11 @@ func (r *Record) Get(name string) (Value, bool) {
 	return value, ok
 }
 
+// GetPath - returns Value of path.
+func (r *Record) GetPath(path []string) (Value, bool) {
+	return r.Get(schema.JoinPath(path))
+}
+
 // Range - calls f sequentially for each name and value present in the record. If f returns false, range stops the iteration.
 func (r *Record) Range(f func(name string, value Value) bool) {
 	for _, name := range r.nameList {
@@ -0,0 +1,280 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// ChangeType - denotes type of change of a field between two trees.
+type ChangeType int
+
+// Change types reported by Compare.
+const (
+	FieldAdded ChangeType = iota + 1
+	FieldRemoved
+	RepetitionChanged
+	TypeChanged
+	ConvertedTypeChanged
+	LogicalTypeChanged
+	TypeLengthChanged
+	PrecisionChanged
+	ScaleChanged
+)
+
+func (changeType ChangeType) String() string {
+	switch changeType {
+	case FieldAdded:
+		return "FieldAdded"
+	case FieldRemoved:
+		return "FieldRemoved"
+	case RepetitionChanged:
+		return "RepetitionChanged"
+	case TypeChanged:
+		return "TypeChanged"
+	case ConvertedTypeChanged:
+		return "ConvertedTypeChanged"
+	case LogicalTypeChanged:
+		return "LogicalTypeChanged"
+	case TypeLengthChanged:
+		return "TypeLengthChanged"
+	case PrecisionChanged:
+		return "PrecisionChanged"
+	case ScaleChanged:
+		return "ScaleChanged"
+	}
+
+	return fmt.Sprintf("ChangeType(%d)", int(changeType))
+}
+
+// Change - denotes a change of a field from tree a to tree b. Backward is true if data written
+// with a can be read with b; Forward is true if data written with b can be read with a.
+type Change struct {
+	Type     ChangeType
+	Path     string // Dot separated path of the field in tree.
+	From     string // Empty for FieldAdded.
+	To       string // Empty for FieldRemoved.
+	Backward bool
+	Forward  bool
+}
+
+func (change Change) String() string {
+	return fmt.Sprintf("%v %v: %v -> %v (backward: %v, forward: %v)",
+		change.Type, change.Path, change.From, change.To, change.Backward, change.Forward)
+}
+
+// Diff - list of changes between two trees.
+type Diff []Change
+
+// BackwardCompatible - returns whether data written with old tree can be read with new tree.
+func (diff Diff) BackwardCompatible() bool {
+	for _, change := range diff {
+		if !change.Backward {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForwardCompatible - returns whether data written with new tree can be read with old tree.
+func (diff Diff) ForwardCompatible() bool {
+	for _, change := range diff {
+		if !change.Forward {
+			return false
+		}
+	}
+
+	return true
+}
+
+func typeString(element *Element) string {
+	if element.Type == nil {
+		return "group"
+	}
+
+	return element.Type.String()
+}
+
+func convertedTypeString(convertedType *parquet.ConvertedType) string {
+	if convertedType == nil {
+		return "<nil>"
+	}
+
+	return convertedType.String()
+}
+
+func int32String(i *int32) string {
+	if i == nil {
+		return "<nil>"
+	}
+
+	return strconv.Itoa(int(*i))
+}
+
+// isAnnotated returns whether values of element's type are interpreted by converted or logical type.
+func isAnnotated(element *Element) bool {
+	return element.ConvertedType != nil || element.LogicalType != nil
+}
+
+func logicalTypeString(logicalType *parquet.LogicalType) string {
+	if logicalType == nil {
+		return "<nil>"
+	}
+
+	return logicalType.String()
+}
+
+// widenings holds element types and converted types those can be promoted to wider one without loss.
+var widenings = map[string]string{
+	parquet.Type_INT32.String():                   parquet.Type_INT64.String(),
+	parquet.Type_FLOAT.String():                   parquet.Type_DOUBLE.String(),
+	parquet.ConvertedType_INT_8.String():          parquet.ConvertedType_INT_16.String(),
+	parquet.ConvertedType_INT_16.String():         parquet.ConvertedType_INT_32.String(),
+	parquet.ConvertedType_INT_32.String():         parquet.ConvertedType_INT_64.String(),
+	parquet.ConvertedType_UINT_8.String():         parquet.ConvertedType_UINT_16.String(),
+	parquet.ConvertedType_UINT_16.String():        parquet.ConvertedType_UINT_32.String(),
+	parquet.ConvertedType_UINT_32.String():        parquet.ConvertedType_UINT_64.String(),
+	parquet.FieldRepetitionType_REQUIRED.String(): parquet.FieldRepetitionType_OPTIONAL.String(),
+}
+
+// isWidening returns whether from can be promoted to to.
+func isWidening(from, to string) bool {
+	for from != "" {
+		if from = widenings[from]; from == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newChange returns change from from to to; widening is backward and narrowing is forward compatible.
+func newChange(changeType ChangeType, path, from, to string) Change {
+	return Change{
+		Type:     changeType,
+		Path:     path,
+		From:     from,
+		To:       to,
+		Backward: isWidening(from, to),
+		Forward:  isWidening(to, from),
+	}
+}
+
+func compareElements(a, b *Element, path string) (diff Diff) {
+	if a.RepetitionType.String() != b.RepetitionType.String() {
+		diff = append(diff, newChange(RepetitionChanged, path, a.RepetitionType.String(), b.RepetitionType.String()))
+	}
+
+	if typeString(a) != typeString(b) {
+		// Comparing converted and logical types of different element types is meaningless. Widening of
+		// annotated type e.g. INT32 (DATE) to INT64 changes meaning of values.
+		change := newChange(TypeChanged, path, typeString(a), typeString(b))
+		if isAnnotated(a) || isAnnotated(b) {
+			change.Backward, change.Forward = false, false
+		}
+		return append(diff, change)
+	}
+
+	// Values of different length, precision or scale are not readable by each other.
+	for _, field := range []struct {
+		changeType ChangeType
+		a, b       *int32
+	}{
+		{TypeLengthChanged, a.TypeLength, b.TypeLength},
+		{PrecisionChanged, a.Precision, b.Precision},
+		{ScaleChanged, a.Scale, b.Scale},
+	} {
+		if from, to := int32String(field.a), int32String(field.b); from != to {
+			diff = append(diff, Change{Type: field.changeType, Path: path, From: from, To: to})
+		}
+	}
+
+	if convertedTypeString(a.ConvertedType) != convertedTypeString(b.ConvertedType) {
+		diff = append(diff, newChange(ConvertedTypeChanged, path,
+			convertedTypeString(a.ConvertedType), convertedTypeString(b.ConvertedType)))
+	}
+
+	if !reflect.DeepEqual(a.LogicalType, b.LogicalType) {
+		diff = append(diff, newChange(LogicalTypeChanged, path,
+			logicalTypeString(a.LogicalType), logicalTypeString(b.LogicalType)))
+	}
+
+	if a.Type == nil {
+		diff = append(diff, compareTrees(a.Children, b.Children, path)...)
+	}
+
+	return diff
+}
+
+func compareTrees(a, b *Tree, prefix string) (diff Diff) {
+	if a == nil {
+		a = NewTree()
+	}
+	if b == nil {
+		b = NewTree()
+	}
+
+	a.Range(func(name string, element *Element) bool {
+		path := appendPath(prefix, name)
+		if other, found := b.GetPath([]string{name}); found {
+			diff = append(diff, compareElements(element, other, path)...)
+			return true
+		}
+
+		// Removed field is ignored by new reader; old reader requires it if not optional.
+		diff = append(diff, Change{
+			Type:     FieldRemoved,
+			Path:     path,
+			From:     typeString(element),
+			Backward: true,
+			Forward:  *element.RepetitionType == parquet.FieldRepetitionType_OPTIONAL,
+		})
+		return true
+	})
+
+	b.Range(func(name string, element *Element) bool {
+		if _, found := a.GetPath([]string{name}); found {
+			return true
+		}
+
+		// Added field is ignored by old reader; new reader requires it if not optional.
+		diff = append(diff, Change{
+			Type:     FieldAdded,
+			Path:     appendPath(prefix, name),
+			To:       typeString(element),
+			Backward: *element.RepetitionType == parquet.FieldRepetitionType_OPTIONAL,
+			Forward:  true,
+		})
+		return true
+	})
+
+	return diff
+}
+
+// Compare - returns changes of fields from tree a to tree b. Repetition types, element types, type
+// lengths, precisions, scales, converted types and logical types of common fields are compared. Widening
+// of INT32 to INT64 and FLOAT to DOUBLE without converted or logical type, narrower INT_*/UINT_* converted
+// types to wider ones and REQUIRED to OPTIONAL are backward compatible; their reverse are forward compatible. Added optional fields are backward
+// compatible and removed optional fields are forward compatible. All other changes are incompatible.
+func Compare(a, b *Tree) Diff {
+	return compareTrees(a, b, "")
+}
@@ -0,0 +1,158 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		a, b             string
+		expectedResult   Diff
+		expectedBackward bool
+		expectedForward  bool
+	}{
+		{
+			"message m { required int32 a; }",
+			"message m { required int32 a; }",
+			nil, true, true,
+		},
+		{
+			"message m { required int32 a; optional float b; }",
+			"message m { required int64 a; optional double b; }",
+			Diff{
+				{Type: TypeChanged, Path: "a", From: "INT32", To: "INT64", Backward: true},
+				{Type: TypeChanged, Path: "b", From: "FLOAT", To: "DOUBLE", Backward: true},
+			},
+			true, false,
+		},
+		{
+			"message m { required int64 a; }",
+			"message m { required int32 a; }",
+			Diff{{Type: TypeChanged, Path: "a", From: "INT64", To: "INT32", Forward: true}},
+			false, true,
+		},
+		{
+			"message m { required int32 a; required binary b; }",
+			"message m { optional int32 a; required int32 b; }",
+			Diff{
+				{Type: RepetitionChanged, Path: "a", From: "REQUIRED", To: "OPTIONAL", Backward: true},
+				{Type: TypeChanged, Path: "b", From: "BYTE_ARRAY", To: "INT32"},
+			},
+			false, false,
+		},
+		{
+			"message m { required int32 a; optional int32 b; }",
+			"message m { required int32 a; optional int32 c; required int32 d; }",
+			Diff{
+				{Type: FieldRemoved, Path: "b", From: "INT32", Backward: true, Forward: true},
+				{Type: FieldAdded, Path: "c", To: "INT32", Backward: true, Forward: true},
+				{Type: FieldAdded, Path: "d", To: "INT32", Forward: true},
+			},
+			false, true,
+		},
+		{
+			"message m { optional group g { required int32 a (INT_8); required binary b; } }",
+			"message m { optional group g { required int32 a (INT_32); required binary b (UTF8); } }",
+			Diff{
+				{Type: ConvertedTypeChanged, Path: "g.a", From: "INT_8", To: "INT_32", Backward: true},
+				{Type: ConvertedTypeChanged, Path: "g.b", From: "<nil>", To: "UTF8"},
+			},
+			false, false,
+		},
+		{
+			"message m { optional group g { required int32 a; } }",
+			"message m { optional int32 g; }",
+			Diff{{Type: TypeChanged, Path: "g", From: "group", To: "INT32"}},
+			false, false,
+		},
+		{
+			"message m { required fixed_len_byte_array(16) a; }",
+			"message m { required fixed_len_byte_array(8) a; }",
+			Diff{{Type: TypeLengthChanged, Path: "a", From: "16", To: "8"}},
+			false, false,
+		},
+		{
+			"message m { required int64 a (DECIMAL(10,2)); }",
+			"message m { required int64 a (DECIMAL(10,4)); }",
+			Diff{{Type: ScaleChanged, Path: "a", From: "2", To: "4"}},
+			false, false,
+		},
+		{
+			"message m { required int64 a (DECIMAL(10,2)); }",
+			"message m { required int64 a (DECIMAL(12,2)); }",
+			Diff{{Type: PrecisionChanged, Path: "a", From: "10", To: "12"}},
+			false, false,
+		},
+		{
+			"message m { required int32 a (DATE); }",
+			"message m { required int64 a; }",
+			Diff{{Type: TypeChanged, Path: "a", From: "INT32", To: "INT64"}},
+			false, false,
+		},
+	}
+
+	for i, testCase := range testCases {
+		a, err := Parse(testCase.a)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		b, err := Parse(testCase.b)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		result := Compare(a, b)
+		if !reflect.DeepEqual(result, testCase.expectedResult) {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+
+		if result.BackwardCompatible() != testCase.expectedBackward {
+			t.Fatalf("case %v: backward: expected: %v, got: %v", i+1, testCase.expectedBackward, result.BackwardCompatible())
+		}
+
+		if result.ForwardCompatible() != testCase.expectedForward {
+			t.Fatalf("case %v: forward: expected: %v, got: %v", i+1, testCase.expectedForward, result.ForwardCompatible())
+		}
+	}
+}
+
+func TestCompareDottedNames(t *testing.T) {
+	newTree := func(elementType parquet.Type) *Tree {
+		element, err := NewElement("a.b", parquet.FieldRepetitionType_REQUIRED, parquet.TypePtr(elementType), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tree := NewTree()
+		if err = tree.SetPath([]string{"a.b"}, element); err != nil {
+			t.Fatal(err)
+		}
+
+		return tree
+	}
+
+	expectedResult := Diff{{Type: TypeChanged, Path: `a\.b`, From: "INT32", To: "INT64", Backward: true}}
+	if result := Compare(newTree(parquet.Type_INT32), newTree(parquet.Type_INT64)); !reflect.DeepEqual(result, expectedResult) {
+		t.Fatalf("expected: %v, got: %v", expectedResult, result)
+	}
+}
@@ -18,35 +18,25 @@ package schema
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 
 	"github.com/minio/parquet-go/gen-go/parquet"
 )
 
-var nameRegexp = regexp.MustCompile("^[a-zA-Z0-9_]+$")
-
-func validataPathSegments(pathSegments []string) error {
-	for _, pathSegment := range pathSegments {
-		if !nameRegexp.MatchString(pathSegment) {
-			return fmt.Errorf("unsupported name %v", strings.Join(pathSegments, "."))
-		}
-	}
-
-	return nil
-}
-
 // Element - represents schema element and its children. Any element must have Name and RepetitionType fields set.
 type Element struct {
 	parquet.SchemaElement
 	numChildren        int32
 	Encoding           *parquet.Encoding         // Optional; defaults is computed.
 	CompressionType    *parquet.CompressionCodec // Optional; defaults to SNAPPY.
+	CompressionLevel   *int                      // Optional; defaults to codec's default level.
+	BloomFilter        bool                      // Optional; writes split-block Bloom filter of column chunks.
+	BloomFilterFPP     *float64                  // Optional; defaults to bloom.DefaultFPP.
 	Children           *Tree
 	MaxDefinitionLevel int64
 	MaxRepetitionLevel int64
-	PathInTree         string
-	PathInSchema       string
+	PathInTree         string // Escaped string form of path; see JoinPath.
+	PathInSchema       string // Escaped string form of path; see JoinPath.
 }
 
 // String - stringify this element.
@@ -60,12 +50,24 @@ func (element *Element) String() string {
 	if element.ConvertedType != nil {
 		s = append(s, "ConvertedType:"+element.ConvertedType.String())
 	}
+	if element.FieldID != nil {
+		s = append(s, fmt.Sprintf("FieldID:%v", *element.FieldID))
+	}
 	if element.Encoding != nil {
 		s = append(s, "Encoding:"+element.Encoding.String())
 	}
 	if element.CompressionType != nil {
 		s = append(s, "CompressionType:"+element.CompressionType.String())
 	}
+	if element.CompressionLevel != nil {
+		s = append(s, fmt.Sprintf("CompressionLevel:%v", *element.CompressionLevel))
+	}
+	if element.BloomFilter {
+		s = append(s, "BloomFilter:true")
+	}
+	if element.BloomFilterFPP != nil {
+		s = append(s, fmt.Sprintf("BloomFilterFPP:%v", *element.BloomFilterFPP))
+	}
 	if element.Children != nil && element.Children.Length() > 0 {
 		s = append(s, "Children:"+element.Children.String())
 	}
@@ -87,8 +89,8 @@ func NewElement(name string, repetitionType parquet.FieldRepetitionType,
 	encoding *parquet.Encoding, compressionType *parquet.CompressionCodec,
 	children *Tree) (*Element, error) {
 
-	if !nameRegexp.MatchString(name) {
-		return nil, fmt.Errorf("unsupported name %v", name)
+	if err := validateName(name); err != nil {
+		return nil, err
 	}
 
 	switch repetitionType {
@@ -97,16 +99,24 @@ func NewElement(name string, repetitionType parquet.FieldRepetitionType,
 		return nil, fmt.Errorf("unknown repetition type %v", repetitionType)
 	}
 
-	if repetitionType == parquet.FieldRepetitionType_REPEATED && (elementType != nil || convertedType != nil) {
-		return nil, fmt.Errorf("repetition type REPEATED should be used in group element")
-	}
-
 	if children != nil && children.Length() != 0 {
 		if elementType != nil {
 			return nil, fmt.Errorf("type should be nil for group element")
 		}
 	}
 
+	if encoding != nil && *encoding == parquet.Encoding_BYTE_STREAM_SPLIT {
+		switch {
+		case elementType == nil:
+			return nil, fmt.Errorf("encoding %v should not be used in group element", *encoding)
+		case *elementType == parquet.Type_INT32, *elementType == parquet.Type_INT64,
+			*elementType == parquet.Type_FLOAT, *elementType == parquet.Type_DOUBLE,
+			*elementType == parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		default:
+			return nil, fmt.Errorf("encoding %v is not supported for type %v", *encoding, *elementType)
+		}
+	}
+
 	element := Element{
 		Encoding:        encoding,
 		CompressionType: compressionType,
@@ -124,3 +134,10 @@ func NewElement(name string, repetitionType parquet.FieldRepetitionType,
 
 	return &element, nil
 }
+
+// SetFieldID - sets field ID of this element and returns the element. Field IDs must be unique in a tree;
+// they are written to the file and let readers identify columns regardless of their names.
+func (element *Element) SetFieldID(fieldID int32) *Element {
+	element.FieldID = &fieldID
+	return element
+}
@@ -0,0 +1,337 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/tidwall/gjson"
+)
+
+type jsonKind int
+
+const (
+	jsonNull jsonKind = iota
+	jsonBoolean
+	jsonInt32
+	jsonInt64
+	jsonDouble
+	jsonString
+	jsonArray
+	jsonObject
+)
+
+func (kind jsonKind) String() string {
+	switch kind {
+	case jsonNull:
+		return "null"
+	case jsonBoolean:
+		return "boolean"
+	case jsonInt32:
+		return "int32"
+	case jsonInt64:
+		return "int64"
+	case jsonDouble:
+		return "double"
+	case jsonString:
+		return "string"
+	case jsonArray:
+		return "array"
+	case jsonObject:
+		return "object"
+	}
+
+	return fmt.Sprintf("jsonKind(%d)", int(kind))
+}
+
+func (kind jsonKind) isNumber() bool {
+	return kind == jsonInt32 || kind == jsonInt64 || kind == jsonDouble
+}
+
+// numberKind returns smallest kind holding number of raw JSON text.
+func numberKind(raw string) jsonKind {
+	if strings.ContainsAny(raw, ".eE") {
+		return jsonDouble
+	}
+
+	i, err := strconv.ParseInt(raw, 10, 64)
+	switch {
+	case err != nil:
+		return jsonDouble
+	case i < math.MinInt32 || i > math.MaxInt32:
+		return jsonInt64
+	}
+
+	return jsonInt32
+}
+
+// Conflict - denotes a field having values of incompatible JSON types in samples.
+type Conflict struct {
+	Path  string   // Path of the field in tree.
+	Kinds []string // JSON types found in order.
+}
+
+// ConflictError - returned by InferFromJSON if samples have conflicting types.
+type ConflictError struct {
+	Conflicts []Conflict
+}
+
+func (err *ConflictError) Error() string {
+	var s []string
+	for _, conflict := range err.Conflicts {
+		s = append(s, fmt.Sprintf("%v: %v", conflict.Path, strings.Join(conflict.Kinds, ", ")))
+	}
+
+	return "conflicting types found; " + strings.Join(s, "; ")
+}
+
+// inferredField holds JSON kind of a field merged over all samples.
+type inferredField struct {
+	kind    jsonKind
+	element *inferredField
+	keys    []string
+	fields  map[string]*inferredField
+}
+
+type inferrer struct {
+	conflicts []Conflict
+}
+
+// addConflict records kind found at path having field of existing kind.
+func (inf *inferrer) addConflict(path string, existing, kind jsonKind) {
+	for i := range inf.conflicts {
+		if inf.conflicts[i].Path != path {
+			continue
+		}
+
+		for _, k := range inf.conflicts[i].Kinds {
+			if k == kind.String() {
+				return
+			}
+		}
+
+		inf.conflicts[i].Kinds = append(inf.conflicts[i].Kinds, kind.String())
+		return
+	}
+
+	inf.conflicts = append(inf.conflicts, Conflict{Path: path, Kinds: []string{existing.String(), kind.String()}})
+}
+
+// merge merges kind of value into field at path.
+func (inf *inferrer) merge(field *inferredField, path string, value gjson.Result) error {
+	var kind jsonKind
+	switch {
+	case value.Type == gjson.Null:
+		return nil
+	case value.Type == gjson.False, value.Type == gjson.True:
+		kind = jsonBoolean
+	case value.Type == gjson.Number:
+		kind = numberKind(value.Raw)
+	case value.Type == gjson.String:
+		kind = jsonString
+	case value.IsArray():
+		kind = jsonArray
+	case value.IsObject():
+		kind = jsonObject
+	default:
+		return fmt.Errorf("%v: unknown JSON value %v", path, value.Raw)
+	}
+
+	switch {
+	case field.kind == jsonNull:
+		field.kind = kind
+	case field.kind.isNumber() && kind.isNumber():
+		if kind > field.kind {
+			field.kind = kind
+		}
+	case field.kind != kind:
+		inf.addConflict(path, field.kind, kind)
+		return nil
+	}
+
+	var err error
+	switch kind {
+	case jsonArray:
+		if field.element == nil {
+			field.element = new(inferredField)
+		}
+
+		elementPath := path + ".list.element"
+		value.ForEach(func(_, v gjson.Result) bool {
+			err = inf.merge(field.element, elementPath, v)
+			return err == nil
+		})
+
+	case jsonObject:
+		if field.fields == nil {
+			field.fields = make(map[string]*inferredField)
+		}
+
+		err = inf.mergeObject(field, path, value)
+	}
+
+	return err
+}
+
+func (inf *inferrer) mergeObject(field *inferredField, path string, value gjson.Result) error {
+	var err error
+	value.ForEach(func(k, v gjson.Result) bool {
+		name := k.String()
+		if err = validateName(name); err != nil {
+			err = fmt.Errorf("%v: %v", path, err)
+			return false
+		}
+
+		child, found := field.fields[name]
+		if !found {
+			child = new(inferredField)
+			field.keys = append(field.keys, name)
+			field.fields[name] = child
+		}
+
+		err = inf.merge(child, appendPath(path, name), v)
+		return err == nil
+	})
+
+	return err
+}
+
+// toElement creates optional element of field at path. Field without non-null value is a string.
+func (field *inferredField) toElement(name, path string) (*Element, error) {
+	var elementType *parquet.Type
+	var convertedType *parquet.ConvertedType
+	var children *Tree
+	var err error
+
+	switch field.kind {
+	case jsonBoolean:
+		elementType = parquet.TypePtr(parquet.Type_BOOLEAN)
+	case jsonInt32:
+		elementType = parquet.TypePtr(parquet.Type_INT32)
+	case jsonInt64:
+		elementType = parquet.TypePtr(parquet.Type_INT64)
+	case jsonDouble:
+		elementType = parquet.TypePtr(parquet.Type_DOUBLE)
+	case jsonArray:
+		element := field.element
+		if element == nil {
+			element = new(inferredField)
+		}
+
+		var valueElement, listElement *Element
+		if valueElement, err = element.toElement("element", path+".list.element"); err != nil {
+			return nil, err
+		}
+
+		listTree := NewTree()
+		if err = listTree.Set("element", valueElement); err != nil {
+			return nil, err
+		}
+
+		if listElement, err = NewElement("list", parquet.FieldRepetitionType_REPEATED, nil, nil, nil, nil, listTree); err != nil {
+			return nil, err
+		}
+
+		children = NewTree()
+		if err = children.Set("list", listElement); err != nil {
+			return nil, err
+		}
+
+		convertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_LIST)
+	case jsonObject:
+		if len(field.keys) == 0 {
+			return nil, fmt.Errorf("%v: only empty objects found; parquet group must have at least one field", path)
+		}
+
+		if children, err = field.toTree(path); err != nil {
+			return nil, err
+		}
+	default:
+		elementType = parquet.TypePtr(parquet.Type_BYTE_ARRAY)
+		convertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+	}
+
+	return NewElement(name, parquet.FieldRepetitionType_OPTIONAL, elementType, convertedType, nil, nil, children)
+}
+
+func (field *inferredField) toTree(path string) (*Tree, error) {
+	tree := NewTree()
+	for _, name := range field.keys {
+		element, err := field.fields[name].toElement(name, appendPath(path, name))
+		if err != nil {
+			return nil, err
+		}
+
+		if err = tree.Set(name, element); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
+
+// InferFromJSON - infers tree from sample JSON objects. All inferred fields are optional; arrays become
+// LIST, objects become groups, strings become UTF8, booleans become BOOLEAN and numbers become
+// INT32, INT64 or DOUBLE widened to hold all sample values. Fields having only null values or empty
+// arrays are inferred as strings; an error is returned for fields having only empty objects as parquet
+// does not allow empty groups. *ConflictError is returned if a field has incompatible types in samples.
+// Returned tree is validated but writable, so fields can be adjusted before ToParquetSchema.
+func InferFromJSON(samples ...[]byte) (*Tree, error) {
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no samples given")
+	}
+
+	inf := new(inferrer)
+	root := &inferredField{kind: jsonObject, fields: make(map[string]*inferredField)}
+	for i, sample := range samples {
+		if !gjson.ValidBytes(sample) {
+			return nil, fmt.Errorf("sample %v: invalid JSON", i+1)
+		}
+
+		value := gjson.ParseBytes(sample)
+		if !value.IsObject() {
+			return nil, fmt.Errorf("sample %v: JSON object expected", i+1)
+		}
+
+		if err := inf.mergeObject(root, "", value); err != nil {
+			return nil, fmt.Errorf("sample %v: %v", i+1, err)
+		}
+	}
+
+	if len(inf.conflicts) != 0 {
+		return nil, &ConflictError{Conflicts: inf.conflicts}
+	}
+
+	if len(root.keys) == 0 {
+		return nil, fmt.Errorf("no fields found in samples")
+	}
+
+	tree, err := root.toTree("")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err = tree.convert(); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
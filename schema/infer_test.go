@@ -0,0 +1,155 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestInferFromJSON(t *testing.T) {
+	testCases := []struct {
+		samples        []string
+		expectedResult string
+		expectErr      bool
+	}{
+		{
+			[]string{
+				`{"id": 1, "name": "foo", "score": 1, "active": true, "tags": ["a"], "address": {"zip": 1}, "extra": null}`,
+				`{"id": 4294967296, "score": 1.5, "tags": [], "address": {"city": "bar"}, "matrix": [[1, 2], [3]]}`,
+			},
+			`message schema {
+  optional int64 id;
+  optional binary name (UTF8);
+  optional double score;
+  optional boolean active;
+  optional group tags (LIST) {
+    repeated group list {
+      optional binary element (UTF8);
+    }
+  }
+  optional group address {
+    optional int32 zip;
+    optional binary city (UTF8);
+  }
+  optional binary extra (UTF8);
+  optional group matrix (LIST) {
+    repeated group list {
+      optional group element (LIST) {
+        repeated group list {
+          optional int32 element;
+        }
+      }
+    }
+  }
+}
+`,
+			false,
+		},
+		{
+			[]string{`{"items": [{"a": 1}, {"a": 2, "b": "x"}]}`},
+			`message schema {
+  optional group items (LIST) {
+    repeated group list {
+      optional group element {
+        optional int32 a;
+        optional binary b (UTF8);
+      }
+    }
+  }
+}
+`,
+			false,
+		},
+		{
+			[]string{`{"a": {}}`, `{"a": {"b": true}}`},
+			`message schema {
+  optional group a {
+    optional boolean b;
+  }
+}
+`,
+			false,
+		},
+		{nil, "", true},                                // error: no samples
+		{[]string{`[1, 2]`}, "", true},                 // error: not an object
+		{[]string{`{"a": `}, "", true},                 // error: invalid JSON
+		{[]string{`{}`}, "", true},                     // error: no fields
+		{[]string{`{"a": {}}`}, "", true},              // error: empty group
+		{[]string{`{"a": [{}]}`}, "", true},            // error: empty group in list
+		{[]string{`{"": 1}`}, "", true},                // error: unsupported name
+		{[]string{`{"a": 1}`, `{"a": "1"}`}, "", true}, // error: conflict
+	}
+
+	for i, testCase := range testCases {
+		var samples [][]byte
+		for _, sample := range testCase.samples {
+			samples = append(samples, []byte(sample))
+		}
+
+		tree, err := InferFromJSON(samples...)
+		expectErr := (err != nil)
+
+		if expectErr != testCase.expectErr {
+			if testCase.expectErr {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			} else {
+				t.Fatalf("case %v: err: expected: <nil>, got: %v", i+1, err)
+			}
+		}
+
+		if expectErr {
+			continue
+		}
+
+		if tree.ReadOnly() {
+			t.Fatalf("case %v: expected: writable tree, got: read only tree", i+1)
+		}
+
+		if result := tree.Format(); result != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+
+		if _, _, err = tree.ToParquetSchema(); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+	}
+}
+
+func TestInferFromJSONConflicts(t *testing.T) {
+	_, err := InferFromJSON(
+		[]byte(`{"a": 1, "b": {"c": true}, "d": [1]}`),
+		[]byte(`{"a": "x", "b": {"c": 2}, "d": {"e": 1}}`),
+		[]byte(`{"a": [1], "b": {"c": 3}}`),
+	)
+
+	var conflictErr *ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected: *ConflictError, got: %v", err)
+	}
+
+	expectedResult := []Conflict{
+		{Path: "a", Kinds: []string{"int32", "string", "array"}},
+		{Path: "b.c", Kinds: []string{"boolean", "int32"}},
+		{Path: "d", Kinds: []string{"array", "object"}},
+	}
+
+	if !reflect.DeepEqual(conflictErr.Conflicts, expectedResult) {
+		t.Fatalf("expected: %v, got: %v", expectedResult, conflictErr.Conflicts)
+	}
+}
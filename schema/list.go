@@ -0,0 +1,115 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// firstChild returns first child of group element.
+func (element *Element) firstChild() (child *Element) {
+	element.Children.Range(func(name string, element *Element) bool {
+		child = element
+		return false
+	})
+
+	return child
+}
+
+// repeatedChild returns the only child of LIST or MAP annotated group which must be repeated.
+func (element *Element) repeatedChild() (*Element, error) {
+	if element.Type != nil {
+		return nil, fmt.Errorf("type must be nil for %v ConvertedType", *element.ConvertedType)
+	}
+
+	if element.Children == nil || element.Children.Length() != 1 {
+		return nil, fmt.Errorf("children must have one element only for %v ConvertedType", *element.ConvertedType)
+	}
+
+	child := element.firstChild()
+	if *child.RepetitionType != parquet.FieldRepetitionType_REPEATED {
+		return nil, fmt.Errorf("%v: repetition type must be REPEATED type", child.Name)
+	}
+
+	return child, nil
+}
+
+// ListElement - returns element of values of LIST annotated group. Besides standard 3-level structure,
+// legacy structures written by parquet-avro, parquet-thrift, Hive and Spark are resolved as per
+// backward compatibility rules of parquet format, i.e. the repeated field is the element if
+//   - it is primitive (2-level list e.g. 'repeated int32 array'),
+//   - it is a group having more than one field,
+//   - it is a group named 'array' or '<list-name>_tuple',
+//
+// otherwise the only field of the repeated group is the element (e.g. 'element', 'array_element').
+func (element *Element) ListElement() (*Element, error) {
+	if element.ConvertedType == nil || *element.ConvertedType != parquet.ConvertedType_LIST {
+		return nil, fmt.Errorf("%v: not LIST ConvertedType", element.Name)
+	}
+
+	repeated, err := element.repeatedChild()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", element.Name, err)
+	}
+
+	switch {
+	case repeated.Type != nil:
+	case repeated.Children == nil || repeated.Children.Length() != 1:
+	case repeated.Name == "array" || repeated.Name == element.Name+"_tuple":
+	default:
+		return repeated.firstChild(), nil
+	}
+
+	return repeated, nil
+}
+
+// MapKeyValue - returns key and value elements of MAP or MAP_KEY_VALUE annotated group. Names of
+// repeated group and its fields are not checked to accept legacy 'map' and 'MAP_KEY_VALUE' structures.
+// value is nil if the repeated group has key field only.
+func (element *Element) MapKeyValue() (key, value *Element, err error) {
+	if element.ConvertedType == nil || (*element.ConvertedType != parquet.ConvertedType_MAP &&
+		*element.ConvertedType != parquet.ConvertedType_MAP_KEY_VALUE) {
+		return nil, nil, fmt.Errorf("%v: not MAP ConvertedType", element.Name)
+	}
+
+	keyValue, err := element.repeatedChild()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%v: %v", element.Name, err)
+	}
+
+	if keyValue.Type != nil || keyValue.Children == nil || keyValue.Children.Length() == 0 || keyValue.Children.Length() > 2 {
+		return nil, nil, fmt.Errorf("%v.%v: must be group of key and value", element.Name, keyValue.Name)
+	}
+
+	keyValue.Children.Range(func(name string, element *Element) bool {
+		if key == nil {
+			key = element
+			return true
+		}
+
+		value = element
+		return false
+	})
+
+	if *key.RepetitionType == parquet.FieldRepetitionType_REPEATED {
+		return nil, nil, fmt.Errorf("%v.%v.%v: key must not be REPEATED", element.Name, keyValue.Name, key.Name)
+	}
+
+	return key, value, nil
+}
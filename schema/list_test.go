@@ -0,0 +1,225 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+func newSchemaElement(name string, repetitionType parquet.FieldRepetitionType, elementType *parquet.Type,
+	convertedType *parquet.ConvertedType, numChildren int32) *parquet.SchemaElement {
+	element := parquet.NewSchemaElement()
+	element.Name = name
+	element.RepetitionType = &repetitionType
+	element.Type = elementType
+	element.ConvertedType = convertedType
+	if numChildren > 0 {
+		element.NumChildren = &numChildren
+	}
+
+	return element
+}
+
+func TestElementListElement(t *testing.T) {
+	int32Type := parquet.TypePtr(parquet.Type_INT32)
+	listType := parquet.ConvertedTypePtr(parquet.ConvertedType_LIST)
+	required := parquet.FieldRepetitionType_REQUIRED
+	optional := parquet.FieldRepetitionType_OPTIONAL
+	repeated := parquet.FieldRepetitionType_REPEATED
+
+	testCases := []struct {
+		schemaList     []*parquet.SchemaElement
+		expectedResult string
+		expectErr      bool
+	}{
+		// Standard 3-level list.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("a", optional, nil, listType, 1),
+			newSchemaElement("list", repeated, nil, nil, 1),
+			newSchemaElement("element", optional, int32Type, nil, 0),
+		}, "a.list.element", false},
+		// 2-level list of parquet-thrift and Hive.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("a", optional, nil, listType, 1),
+			newSchemaElement("array", repeated, int32Type, nil, 0),
+		}, "a.array", false},
+		// Spark and Hive list of bag.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("a", optional, nil, listType, 1),
+			newSchemaElement("bag", repeated, nil, nil, 1),
+			newSchemaElement("array_element", optional, int32Type, nil, 0),
+		}, "a.bag.array_element", false},
+		// parquet-avro list of records.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("a", optional, nil, listType, 1),
+			newSchemaElement("array", repeated, nil, nil, 1),
+			newSchemaElement("x", required, int32Type, nil, 0),
+		}, "a.array", false},
+		// parquet-thrift list of structs.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("a", optional, nil, listType, 1),
+			newSchemaElement("a_tuple", repeated, nil, nil, 1),
+			newSchemaElement("x", required, int32Type, nil, 0),
+		}, "a.a_tuple", false},
+		// Repeated group of more than one field.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("a", optional, nil, listType, 1),
+			newSchemaElement("pair", repeated, nil, nil, 2),
+			newSchemaElement("x", required, int32Type, nil, 0),
+			newSchemaElement("y", required, int32Type, nil, 0),
+		}, "a.pair", false},
+		// error: child is not repeated.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("a", optional, nil, listType, 1),
+			newSchemaElement("list", optional, nil, nil, 1),
+			newSchemaElement("element", optional, int32Type, nil, 0),
+		}, "", true},
+		// error: more than one child.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("a", optional, nil, listType, 2),
+			newSchemaElement("x", repeated, int32Type, nil, 0),
+			newSchemaElement("y", repeated, int32Type, nil, 0),
+		}, "", true},
+	}
+
+	for i, testCase := range testCases {
+		schemaList := append([]*parquet.SchemaElement{newSchemaElement("schema", required, nil, nil, 1)}, testCase.schemaList...)
+		tree, err := FromParquetSchema(schemaList)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		_, _, toErr := tree.ToParquetSchema()
+
+		a, _ := tree.Get("a")
+		element, err := a.ListElement()
+		expectErr := (err != nil)
+
+		if expectErr != testCase.expectErr {
+			if testCase.expectErr {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			} else {
+				t.Fatalf("case %v: err: expected: <nil>, got: %v", i+1, err)
+			}
+		}
+
+		if (toErr != nil) != testCase.expectErr {
+			t.Fatalf("case %v: ToParquetSchema: err: expected: %v, got: %v", i+1, testCase.expectErr, toErr)
+		}
+
+		if !testCase.expectErr && element.PathInSchema != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, element.PathInSchema)
+		}
+	}
+}
+
+func TestElementMapKeyValue(t *testing.T) {
+	utf8Type := parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+	mapType := parquet.ConvertedTypePtr(parquet.ConvertedType_MAP)
+	mapKeyValueType := parquet.ConvertedTypePtr(parquet.ConvertedType_MAP_KEY_VALUE)
+	binaryType := parquet.TypePtr(parquet.Type_BYTE_ARRAY)
+	int32Type := parquet.TypePtr(parquet.Type_INT32)
+	required := parquet.FieldRepetitionType_REQUIRED
+	optional := parquet.FieldRepetitionType_OPTIONAL
+	repeated := parquet.FieldRepetitionType_REPEATED
+
+	testCases := []struct {
+		schemaList    []*parquet.SchemaElement
+		expectedKey   string
+		expectedValue string
+		expectErr     bool
+	}{
+		// Standard map.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("m", optional, nil, mapType, 1),
+			newSchemaElement("key_value", repeated, nil, nil, 2),
+			newSchemaElement("key", required, binaryType, utf8Type, 0),
+			newSchemaElement("value", optional, int32Type, nil, 0),
+		}, "m.key_value.key", "m.key_value.value", false},
+		// Legacy map of MAP_KEY_VALUE annotated repeated group.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("m", optional, nil, mapType, 1),
+			newSchemaElement("map", repeated, nil, mapKeyValueType, 2),
+			newSchemaElement("key", required, binaryType, utf8Type, 0),
+			newSchemaElement("value", optional, int32Type, nil, 0),
+		}, "m.map.key", "m.map.value", false},
+		// Legacy MAP_KEY_VALUE annotated map of keys only.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("m", optional, nil, mapKeyValueType, 1),
+			newSchemaElement("map", repeated, nil, nil, 1),
+			newSchemaElement("key", required, binaryType, utf8Type, 0),
+		}, "m.map.key", "", false},
+		// error: repeated group has more than two fields.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("m", optional, nil, mapType, 1),
+			newSchemaElement("map", repeated, nil, nil, 3),
+			newSchemaElement("key", required, binaryType, utf8Type, 0),
+			newSchemaElement("value", optional, int32Type, nil, 0),
+			newSchemaElement("other", optional, int32Type, nil, 0),
+		}, "", "", true},
+		// error: key is repeated.
+		{[]*parquet.SchemaElement{
+			newSchemaElement("m", optional, nil, mapType, 1),
+			newSchemaElement("map", repeated, nil, nil, 1),
+			newSchemaElement("key", repeated, binaryType, utf8Type, 0),
+		}, "", "", true},
+	}
+
+	for i, testCase := range testCases {
+		schemaList := append([]*parquet.SchemaElement{newSchemaElement("schema", required, nil, nil, 1)}, testCase.schemaList...)
+		tree, err := FromParquetSchema(schemaList)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		_, _, toErr := tree.ToParquetSchema()
+
+		m, _ := tree.Get("m")
+		key, value, err := m.MapKeyValue()
+		expectErr := (err != nil)
+
+		if expectErr != testCase.expectErr {
+			if testCase.expectErr {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			} else {
+				t.Fatalf("case %v: err: expected: <nil>, got: %v", i+1, err)
+			}
+		}
+
+		if (toErr != nil) != testCase.expectErr {
+			t.Fatalf("case %v: ToParquetSchema: err: expected: %v, got: %v", i+1, testCase.expectErr, toErr)
+		}
+
+		if testCase.expectErr {
+			continue
+		}
+
+		if key.PathInSchema != testCase.expectedKey {
+			t.Fatalf("case %v: key: expected: %v, got: %v", i+1, testCase.expectedKey, key.PathInSchema)
+		}
+
+		valuePath := ""
+		if value != nil {
+			valuePath = value.PathInSchema
+		}
+		if valuePath != testCase.expectedValue {
+			t.Fatalf("case %v: value: expected: %v, got: %v", i+1, testCase.expectedValue, valuePath)
+		}
+	}
+}
@@ -0,0 +1,623 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// punctuations separate words of message type text.
+const punctuations = "{}();=,"
+
+// tokenize splits message type text into words and punctuations. Double quoted name is a word including
+// its quotes.
+func tokenize(s string) (tokens []string) {
+	word := ""
+	quoted, escaped := false, false
+	for _, r := range s {
+		switch {
+		case quoted:
+			word += string(r)
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				quoted = false
+				tokens = append(tokens, word)
+				word = ""
+			}
+			continue
+		case r == '"' && word == "":
+			quoted = true
+			word = string(r)
+			continue
+		case unicode.IsSpace(r):
+		case strings.ContainsRune(punctuations, r):
+			if word != "" {
+				tokens = append(tokens, word)
+				word = ""
+			}
+			tokens = append(tokens, string(r))
+			continue
+		default:
+			word += string(r)
+			continue
+		}
+
+		if word != "" {
+			tokens = append(tokens, word)
+			word = ""
+		}
+	}
+
+	if word != "" {
+		tokens = append(tokens, word)
+	}
+
+	return tokens
+}
+
+type parser struct {
+	tokens []string
+	index  int
+	legacy bool // Legacy LIST or MAP structure is found.
+}
+
+func (p *parser) peek() string {
+	if p.index < len(p.tokens) {
+		return p.tokens[p.index]
+	}
+
+	return ""
+}
+
+func (p *parser) next() (string, error) {
+	if p.index >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of schema")
+	}
+
+	p.index++
+	return p.tokens[p.index-1], nil
+}
+
+func (p *parser) expect(token string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(t, token) {
+		return fmt.Errorf("expected %q, got %q", token, t)
+	}
+
+	return nil
+}
+
+func (p *parser) int32() (int32, error) {
+	t, err := p.next()
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := strconv.ParseInt(t, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("expected integer, got %q", t)
+	}
+
+	return int32(i), nil
+}
+
+// name returns next token as name; double quoted name is unquoted.
+func (p *parser) name() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.HasPrefix(t, `"`) {
+		return t, nil
+	}
+
+	name, err := strconv.Unquote(t)
+	if err != nil {
+		return "", fmt.Errorf("invalid quoted name %v", t)
+	}
+
+	return name, nil
+}
+
+// timeUnit parses unit of TIME and TIMESTAMP logical types.
+func (p *parser) timeUnit() (*parquet.TimeUnit, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToUpper(t) {
+	case "MILLIS":
+		return &parquet.TimeUnit{MILLIS: parquet.NewMilliSeconds()}, nil
+	case "MICROS":
+		return &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()}, nil
+	case "NANOS":
+		return &parquet.TimeUnit{NANOS: parquet.NewNanoSeconds()}, nil
+	}
+
+	return nil, fmt.Errorf("unknown time unit %v", t)
+}
+
+// bool parses true or false.
+func (p *parser) bool() (bool, error) {
+	t, err := p.next()
+	if err != nil {
+		return false, err
+	}
+
+	b, err := strconv.ParseBool(t)
+	if err != nil {
+		return false, fmt.Errorf("expected true or false, got %q", t)
+	}
+
+	return b, nil
+}
+
+// parseLogicalType parses logical type annotation t those is not a converted type, i.e. STRING, UUID,
+// INTEGER(bitWidth,isSigned), TIME(unit,isAdjustedToUTC) and TIMESTAMP(unit,isAdjustedToUTC). Equivalent
+// converted type, if any, is returned too.
+func (p *parser) parseLogicalType(t string) (logicalType *parquet.LogicalType, convertedType *parquet.ConvertedType, err error) {
+	logicalType = parquet.NewLogicalType()
+	switch strings.ToUpper(t) {
+	case "STRING":
+		logicalType.STRING = parquet.NewStringType()
+		return logicalType, parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8), nil
+	case "UUID":
+		logicalType.UUID = parquet.NewUUIDType()
+		return logicalType, nil, nil
+	case "INTEGER":
+		if err = p.expect("("); err != nil {
+			return nil, nil, err
+		}
+
+		var bitWidth int32
+		if bitWidth, err = p.int32(); err != nil {
+			return nil, nil, err
+		}
+
+		if err = p.expect(","); err != nil {
+			return nil, nil, err
+		}
+
+		var isSigned bool
+		if isSigned, err = p.bool(); err != nil {
+			return nil, nil, err
+		}
+
+		if err = p.expect(")"); err != nil {
+			return nil, nil, err
+		}
+
+		prefix := "UINT_"
+		if isSigned {
+			prefix = "INT_"
+		}
+
+		ct, err := parquet.ConvertedTypeFromString(prefix + strconv.Itoa(int(bitWidth)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unsupported INTEGER bit width %v", bitWidth)
+		}
+
+		logicalType.INTEGER = &parquet.IntType{BitWidth: int8(bitWidth), IsSigned: isSigned}
+		return logicalType, &ct, nil
+	case "TIME", "TIMESTAMP":
+		if err = p.expect("("); err != nil {
+			return nil, nil, err
+		}
+
+		var unit *parquet.TimeUnit
+		if unit, err = p.timeUnit(); err != nil {
+			return nil, nil, err
+		}
+
+		if err = p.expect(","); err != nil {
+			return nil, nil, err
+		}
+
+		var isAdjustedToUTC bool
+		if isAdjustedToUTC, err = p.bool(); err != nil {
+			return nil, nil, err
+		}
+
+		if err = p.expect(")"); err != nil {
+			return nil, nil, err
+		}
+
+		// Converted types are equivalent to UTC adjusted MILLIS and MICROS units only.
+		name := strings.ToUpper(t)
+		if name == "TIME" {
+			logicalType.TIME = &parquet.TimeType{IsAdjustedToUTC: isAdjustedToUTC, Unit: unit}
+		} else {
+			logicalType.TIMESTAMP = &parquet.TimestampType{IsAdjustedToUTC: isAdjustedToUTC, Unit: unit}
+		}
+
+		switch {
+		case !isAdjustedToUTC:
+		case unit.MILLIS != nil:
+			ct, _ := parquet.ConvertedTypeFromString(name + "_MILLIS")
+			convertedType = &ct
+		case unit.MICROS != nil:
+			ct, _ := parquet.ConvertedTypeFromString(name + "_MICROS")
+			convertedType = &ct
+		}
+
+		return logicalType, convertedType, nil
+	}
+
+	return nil, nil, fmt.Errorf("unknown converted type %v", t)
+}
+
+// parseType parses primitive type or group.
+func (p *parser) parseType() (elementType *parquet.Type, typeLength *int32, err error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch strings.ToLower(t) {
+	case "group":
+		return nil, nil, nil
+	case "binary":
+		return parquet.TypePtr(parquet.Type_BYTE_ARRAY), nil, nil
+	case "fixed_len_byte_array":
+		if err = p.expect("("); err != nil {
+			return nil, nil, err
+		}
+
+		length, err := p.int32()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = p.expect(")"); err != nil {
+			return nil, nil, err
+		}
+
+		return parquet.TypePtr(parquet.Type_FIXED_LEN_BYTE_ARRAY), &length, nil
+	}
+
+	parquetType, err := parquet.TypeFromString(strings.ToUpper(t))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown type %v", t)
+	}
+
+	return &parquetType, nil, nil
+}
+
+// parseField parses a field and its children, and sets it into tree.
+func (p *parser) parseField(tree *Tree) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+
+	repetitionType, err := parquet.FieldRepetitionTypeFromString(strings.ToUpper(t))
+	if err != nil {
+		return fmt.Errorf("unknown repetition type %v", t)
+	}
+
+	elementType, typeLength, err := p.parseType()
+	if err != nil {
+		return err
+	}
+
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+
+	var convertedType *parquet.ConvertedType
+	var logicalType *parquet.LogicalType
+	var precision, scale *int32
+	if p.peek() == "(" {
+		p.index++
+		if t, err = p.next(); err != nil {
+			return err
+		}
+
+		ct, err := parquet.ConvertedTypeFromString(strings.ToUpper(t))
+		if err == nil {
+			convertedType = &ct
+		} else if logicalType, convertedType, err = p.parseLogicalType(t); err != nil {
+			return fmt.Errorf("%v: %v", name, err)
+		}
+
+		if convertedType != nil && *convertedType == parquet.ConvertedType_DECIMAL && p.peek() == "(" {
+			p.index++
+			p32, err := p.int32()
+			if err != nil {
+				return err
+			}
+			precision = &p32
+
+			if err = p.expect(","); err != nil {
+				return err
+			}
+
+			s32, err := p.int32()
+			if err != nil {
+				return err
+			}
+			scale = &s32
+
+			if err = p.expect(")"); err != nil {
+				return err
+			}
+		}
+
+		if err = p.expect(")"); err != nil {
+			return err
+		}
+	}
+
+	var fieldID *int32
+	if p.peek() == "=" {
+		p.index++
+		id, err := p.int32()
+		if err != nil {
+			return err
+		}
+		fieldID = &id
+	}
+
+	var children *Tree
+	if elementType == nil {
+		if children, err = p.parseGroup(); err != nil {
+			return fmt.Errorf("%v.%v", name, err)
+		}
+	} else if err = p.expect(";"); err != nil {
+		return fmt.Errorf("%v: %v", name, err)
+	}
+
+	element, err := NewElement(name, repetitionType, elementType, convertedType, nil, nil, children)
+	if err != nil {
+		return err
+	}
+	element.TypeLength = typeLength
+	element.Precision = precision
+	element.Scale = scale
+	element.FieldID = fieldID
+	element.LogicalType = logicalType
+
+	if _, found := tree.schemaMap[name]; found {
+		return fmt.Errorf("duplicate field %v", name)
+	}
+
+	if isLegacyStructure(element) {
+		p.legacy = true
+	}
+
+	return tree.SetPath([]string{name}, element)
+}
+
+// isLegacyStructure returns whether element is LIST or MAP group of structure other than the one written by
+// this package, e.g. two-level LIST 'optional group a (LIST) { repeated int32 array; }' of older writers.
+func isLegacyStructure(element *Element) bool {
+	if element.ConvertedType == nil || element.Children == nil || element.Children.Length() != 1 {
+		return false
+	}
+
+	var repeated *Element
+	element.Children.Range(func(name string, child *Element) bool {
+		repeated = child
+		return false
+	})
+
+	if *repeated.RepetitionType != parquet.FieldRepetitionType_REPEATED {
+		return false
+	}
+
+	switch *element.ConvertedType {
+	case parquet.ConvertedType_LIST:
+		if repeated.Name != "list" || repeated.Type != nil || repeated.Children == nil || repeated.Children.Length() != 1 {
+			return true
+		}
+
+		_, found := repeated.Children.Get("element")
+		return !found
+	case parquet.ConvertedType_MAP:
+		return repeated.Name != "key_value" || repeated.ConvertedType != nil
+	case parquet.ConvertedType_MAP_KEY_VALUE:
+		return true
+	}
+
+	return false
+}
+
+// parseGroup parses fields enclosed in braces.
+func (p *parser) parseGroup() (*Tree, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	tree := NewTree()
+	for p.peek() != "}" {
+		if err := p.parseField(tree); err != nil {
+			return nil, err
+		}
+	}
+	p.index++
+
+	return tree, nil
+}
+
+// Parse - parses schema in parquet message type format, which is used by parquet-mr and parquet-tools, e.g.
+//
+//	message m {
+//	  required int64 id;
+//	  optional group tags (LIST) {
+//	    repeated group list {
+//	      optional binary element (UTF8);
+//	    }
+//	  }
+//	}
+//
+// and returns read only tree; use ParquetSchema to get its parquet SchemaElements. Logical type annotations
+// of parquet-mr, e.g. (STRING) and (TIMESTAMP(MILLIS,true)), set LogicalType and its equivalent converted
+// type; those of the same name as a converted type, e.g. (DATE), are read as converted type. Legacy LIST
+// and MAP structures are accepted as per backward compatibility rules. Names other than a word, e.g. having
+// spaces or punctuations, are double quoted as Go string literal.
+func Parse(s string) (*Tree, error) {
+	p := &parser{tokens: tokenize(s)}
+	if err := p.expect("message"); err != nil {
+		return nil, err
+	}
+
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := p.parseGroup()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.index != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q after message", p.peek())
+	}
+
+	tree.name = name
+	tree.legacy = p.legacy
+	if _, _, err = tree.ToParquetSchema(); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+// formatName returns name double quoted if it is not a word of message type text.
+func formatName(name string) string {
+	if name == "" || strings.ContainsAny(name, punctuations+`"`) {
+		return strconv.Quote(name)
+	}
+
+	for _, r := range name {
+		if unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return strconv.Quote(name)
+		}
+	}
+
+	return name
+}
+
+func timeUnitString(unit *parquet.TimeUnit) string {
+	switch {
+	case unit == nil:
+	case unit.MILLIS != nil:
+		return "MILLIS"
+	case unit.MICROS != nil:
+		return "MICROS"
+	case unit.NANOS != nil:
+		return "NANOS"
+	}
+
+	return ""
+}
+
+// logicalTypeAnnotation returns annotation of logical type parsed by parseLogicalType or empty string.
+func logicalTypeAnnotation(logicalType *parquet.LogicalType) string {
+	switch {
+	case logicalType == nil:
+	case logicalType.STRING != nil:
+		return "STRING"
+	case logicalType.UUID != nil:
+		return "UUID"
+	case logicalType.INTEGER != nil:
+		return fmt.Sprintf("INTEGER(%v,%v)", logicalType.INTEGER.BitWidth, logicalType.INTEGER.IsSigned)
+	case logicalType.TIME != nil:
+		return fmt.Sprintf("TIME(%v,%v)", timeUnitString(logicalType.TIME.Unit), logicalType.TIME.IsAdjustedToUTC)
+	case logicalType.TIMESTAMP != nil:
+		return fmt.Sprintf("TIMESTAMP(%v,%v)", timeUnitString(logicalType.TIMESTAMP.Unit), logicalType.TIMESTAMP.IsAdjustedToUTC)
+	}
+
+	return ""
+}
+
+func formatTree(builder *strings.Builder, tree *Tree, indent string) {
+	tree.Range(func(name string, element *Element) bool {
+		builder.WriteString(indent)
+		builder.WriteString(strings.ToLower(element.RepetitionType.String()))
+
+		switch {
+		case element.Type == nil:
+			builder.WriteString(" group")
+		case *element.Type == parquet.Type_BYTE_ARRAY:
+			builder.WriteString(" binary")
+		case *element.Type == parquet.Type_FIXED_LEN_BYTE_ARRAY:
+			fmt.Fprintf(builder, " fixed_len_byte_array(%v)", element.GetTypeLength())
+		default:
+			builder.WriteString(" " + strings.ToLower(element.Type.String()))
+		}
+
+		builder.WriteString(" " + formatName(element.Name))
+
+		if annotation := logicalTypeAnnotation(element.LogicalType); annotation != "" {
+			builder.WriteString(" (" + annotation + ")")
+		} else if element.ConvertedType != nil {
+			builder.WriteString(" (" + element.ConvertedType.String())
+			if *element.ConvertedType == parquet.ConvertedType_DECIMAL {
+				fmt.Fprintf(builder, "(%v,%v)", element.GetPrecision(), element.GetScale())
+			}
+			builder.WriteString(")")
+		}
+
+		if element.FieldID != nil {
+			fmt.Fprintf(builder, " = %v", *element.FieldID)
+		}
+
+		if element.Type != nil {
+			builder.WriteString(";\n")
+			return true
+		}
+
+		builder.WriteString(" {\n")
+		if element.Children != nil {
+			formatTree(builder, element.Children, indent+"  ")
+		}
+		builder.WriteString(indent + "}\n")
+		return true
+	})
+}
+
+// Format - returns schema in parquet message type format. It is inverse of Parse.
+func (tree *Tree) Format() string {
+	name := tree.name
+	if name == "" {
+		name = defaultRootName
+	}
+
+	builder := new(strings.Builder)
+	builder.WriteString("message " + formatName(name) + " {\n")
+	formatTree(builder, tree, "  ")
+	builder.WriteString("}\n")
+	return builder.String()
+}
@@ -0,0 +1,261 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		schema         string
+		expectedResult string
+		expectErr      bool
+	}{
+		{
+			"message m { required int64 id; optional group tags (LIST) { repeated group list { optional binary element (UTF8); } } }",
+			`message m {
+  required int64 id;
+  optional group tags (LIST) {
+    repeated group list {
+      optional binary element (UTF8);
+    }
+  }
+}
+`,
+			false,
+		},
+		{
+			`MESSAGE doc {
+  REQUIRED INT32 a (INT_16) = 1;
+  optional fixed_len_byte_array(16) b = 2;
+  optional group c (MAP) {
+    repeated group key_value {
+      required binary key (UTF8);
+      optional double value;
+    }
+  }
+}`,
+			`message doc {
+  required int32 a (INT_16) = 1;
+  optional fixed_len_byte_array(16) b = 2;
+  optional group c (MAP) {
+    repeated group key_value {
+      required binary key (UTF8);
+      optional double value;
+    }
+  }
+}
+`,
+			false,
+		},
+		{
+			`message "my schema" { required int32 a.b; optional binary "c d" (UTF8); required int64 "x;\"y\"" = 3; }`,
+			`message "my schema" {
+  required int32 a.b;
+  optional binary "c d" (UTF8);
+  required int64 "x;\"y\"" = 3;
+}
+`,
+			false,
+		},
+		{
+			`message m {
+  required binary s (STRING);
+  required int64 t (TIMESTAMP(MILLIS,true));
+  optional int64 u (timestamp(micros, false));
+  required int32 i (INTEGER(8,false));
+  required int64 n (TIME(NANOS,true));
+  required fixed_len_byte_array(16) id (UUID);
+  required int32 d (DATE);
+}`,
+			`message m {
+  required binary s (STRING);
+  required int64 t (TIMESTAMP(MILLIS,true));
+  optional int64 u (TIMESTAMP(MICROS,false));
+  required int32 i (INTEGER(8,false));
+  required int64 n (TIME(NANOS,true));
+  required fixed_len_byte_array(16) id (UUID);
+  required int32 d (DATE);
+}
+`,
+			false,
+		},
+		{
+			`message m {
+  optional group a (LIST) { repeated int32 array; }
+  optional group b (LIST) { repeated group bag { optional binary array_element (UTF8); } }
+  optional group c (MAP) { repeated group map (MAP_KEY_VALUE) { required binary key (UTF8); optional int32 value; } }
+}`,
+			`message m {
+  optional group a (LIST) {
+    repeated int32 array;
+  }
+  optional group b (LIST) {
+    repeated group bag {
+      optional binary array_element (UTF8);
+    }
+  }
+  optional group c (MAP) {
+    repeated group map (MAP_KEY_VALUE) {
+      required binary key (UTF8);
+      optional int32 value;
+    }
+  }
+}
+`,
+			false,
+		},
+		{"", "", true}, // error: missing message
+		{"message m { required int96x a; }", "", true},                            // error: unknown type
+		{"message m { mandatory int32 a; }", "", true},                            // error: unknown repetition type
+		{"message m { required int32 a }", "", true},                              // error: missing semicolon
+		{"message m { required int32 a; required int64 a; }", "", true},           // error: duplicate field
+		{"message m { required int32 a (FOO); }", "", true},                       // error: unknown converted type
+		{"message m { required int32 a; } }", "", true},                           // error: unexpected token after message
+		{"message m { optional group a (LIST) { optional int32 b; } }", "", true}, // error: invalid LIST
+		{"message m { repeated int32 a; }", "", true},                             // error: repeated primitive
+		{"message m { required int32 a (INTEGER(12,true)); }", "", true},          // error: unsupported bit width
+		{"message m { required int64 a (TIMESTAMP(SECONDS,true)); }", "", true},   // error: unknown time unit
+		{`message m { required int32 "a; }`, "", true},                            // error: unterminated quoted name
+	}
+
+	for i, testCase := range testCases {
+		tree, err := Parse(testCase.schema)
+		expectErr := (err != nil)
+
+		if expectErr != testCase.expectErr {
+			if testCase.expectErr {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			} else {
+				t.Fatalf("case %v: err: expected: <nil>, got: %v", i+1, err)
+			}
+		}
+
+		if expectErr {
+			continue
+		}
+
+		if !tree.ReadOnly() {
+			t.Fatalf("case %v: expected: read only tree, got: writable tree", i+1)
+		}
+
+		result := tree.Format()
+		if result != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+
+		if tree, err = Parse(result); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if result = tree.Format(); result != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestParseToParquetSchema(t *testing.T) {
+	tree, err := Parse("message m { required int64 id; optional group tags (LIST) { repeated group list { optional binary element (UTF8); } } }")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = tree.ToParquetSchema(); err == nil {
+		t.Fatalf("ToParquetSchema: expected: <error>, got: <nil>")
+	}
+
+	schemaList, valueElements, err := tree.ParquetSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if schemaList[0].Name != "m" {
+		t.Fatalf("expected: m, got: %v", schemaList[0].Name)
+	}
+
+	if len(schemaList) != 5 || len(valueElements) != 2 {
+		t.Fatalf("expected: 5 schema elements and 2 value elements, got: %v and %v", len(schemaList), len(valueElements))
+	}
+
+	element := valueElements[1]
+	if element.PathInSchema != "tags.list.element" || element.MaxDefinitionLevel != 3 || element.MaxRepetitionLevel != 1 {
+		t.Fatalf("unexpected element %v", element)
+	}
+
+	result, err := FromParquetSchema(schemaList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Format() != tree.Format() {
+		t.Fatalf("expected: %v, got: %v", tree.Format(), result.Format())
+	}
+
+	if *valueElements[0].Type != parquet.Type_INT64 {
+		t.Fatalf("expected: INT64, got: %v", valueElements[0].Type)
+	}
+}
+
+func TestParseAnnotations(t *testing.T) {
+	tree, err := Parse(`message m {
+  required binary s (STRING);
+  required int64 t (TIMESTAMP(MILLIS,true));
+  optional int64 u (TIMESTAMP(MICROS,false));
+  required int32 i (INTEGER(8,false));
+  required int32 a.b;
+  optional group l (LIST) { repeated int32 array; }
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		path                  []string
+		expectedConvertedType string
+		expectedLogicalType   string
+	}{
+		{[]string{"s"}, "UTF8", "STRING"},
+		{[]string{"t"}, "TIMESTAMP_MILLIS", "TIMESTAMP(MILLIS,true)"},
+		{[]string{"u"}, "<nil>", "TIMESTAMP(MICROS,false)"},
+		{[]string{"i"}, "UINT_8", "INTEGER(8,false)"},
+		{[]string{"a.b"}, "<nil>", ""},
+		{[]string{"l", "array"}, "<nil>", ""},
+	}
+
+	for i, testCase := range testCases {
+		element, ok := tree.GetPath(testCase.path)
+		if !ok {
+			t.Fatalf("case %v: %v: not found", i+1, testCase.path)
+		}
+
+		if result := convertedTypeString(element.ConvertedType); result != testCase.expectedConvertedType {
+			t.Fatalf("case %v: converted type: expected: %v, got: %v", i+1, testCase.expectedConvertedType, result)
+		}
+
+		if result := logicalTypeAnnotation(element.LogicalType); result != testCase.expectedLogicalType {
+			t.Fatalf("case %v: logical type: expected: %v, got: %v", i+1, testCase.expectedLogicalType, result)
+		}
+	}
+
+	listElement, _ := tree.Get("l")
+	if valueElement, err := listElement.ListElement(); err != nil || valueElement.Name != "array" {
+		t.Fatalf("l: expected: array, got: %v, %v", valueElement, err)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	pathSeparator = '.'
+	pathEscape    = '\\'
+)
+
+// validateName returns error if name is not permitted by parquet format i.e. it is empty or not UTF-8.
+func validateName(name string) error {
+	if name == "" || !utf8.ValidString(name) {
+		return fmt.Errorf("unsupported name %q", name)
+	}
+
+	return nil
+}
+
+// escapeName returns name with '.' and '\' escaped by '\'.
+func escapeName(name string) string {
+	if !strings.ContainsAny(name, `.\`) {
+		return name
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] == pathSeparator || name[i] == pathEscape {
+			sb.WriteByte(pathEscape)
+		}
+		sb.WriteByte(name[i])
+	}
+
+	return sb.String()
+}
+
+// appendPath returns escaped path of name in prefix.
+func appendPath(prefix, name string) string {
+	if prefix == "" {
+		return escapeName(name)
+	}
+
+	return prefix + string(pathSeparator) + escapeName(name)
+}
+
+// JoinPath - returns escaped string form of path. Segments are joined by '.' and any '.' or '\' in
+// segments is escaped by '\' e.g. []string{"a.b", "c"} is "a\.b.c"; names without '.' and '\' are
+// unchanged. Paths of elements, columns and records are in this form.
+func JoinPath(path []string) string {
+	var s string
+	for i, name := range path {
+		if i == 0 {
+			s = escapeName(name)
+		} else {
+			s = appendPath(s, name)
+		}
+	}
+
+	return s
+}
+
+// SplitPath - returns path of escaped string form s. It is inverse of JoinPath.
+func SplitPath(s string) []string {
+	var path []string
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == pathEscape && i+1 < len(s):
+			i++
+			sb.WriteByte(s[i])
+		case s[i] == pathSeparator:
+			path = append(path, sb.String())
+			sb.Reset()
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+
+	return append(path, sb.String())
+}
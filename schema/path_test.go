@@ -0,0 +1,90 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+func TestJoinSplitPath(t *testing.T) {
+	testCases := []struct {
+		path           []string
+		expectedResult string
+	}{
+		{[]string{"a"}, "a"},
+		{[]string{"a", "b", "c"}, "a.b.c"},
+		{[]string{"a.b", "c"}, `a\.b.c`},
+		{[]string{`a\b`, "c"}, `a\\b.c`},
+		{[]string{`a\.`, "."}, `a\\\..\.`},
+		{[]string{"first name", "città"}, "first name.città"},
+	}
+
+	for i, testCase := range testCases {
+		result := JoinPath(testCase.path)
+		if result != testCase.expectedResult {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+
+		if path := SplitPath(result); !reflect.DeepEqual(path, testCase.path) {
+			t.Fatalf("case %v: expected: %q, got: %q", i+1, testCase.path, path)
+		}
+	}
+}
+
+func TestTreeSetPath(t *testing.T) {
+	group, err := NewElement("a.b", parquet.FieldRepetitionType_REQUIRED, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leaf, err := NewElement("c d", parquet.FieldRepetitionType_REQUIRED,
+		parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = NewElement("", parquet.FieldRepetitionType_REQUIRED, nil, nil, nil, nil, nil); err == nil {
+		t.Fatalf("err: expected: <error>, got: <nil>")
+	}
+
+	tree := NewTree()
+	if err = tree.SetPath([]string{"a.b"}, group); err != nil {
+		t.Fatal(err)
+	}
+	if err = tree.SetPath([]string{"a.b", "c d"}, leaf); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found := tree.GetPath([]string{"a", "b"}); found {
+		t.Fatalf("expected: <not found>, got: <found>")
+	}
+
+	if element, found := tree.Get(`a\.b.c d`); !found || element != leaf {
+		t.Fatalf("expected: %v, got: %v", leaf, element)
+	}
+
+	if _, _, err = tree.ToParquetSchema(); err != nil {
+		t.Fatal(err)
+	}
+
+	if leaf.PathInSchema != `a\.b.c d` {
+		t.Fatalf("expected: %v, got: %v", `a\.b.c d`, leaf.PathInSchema)
+	}
+}
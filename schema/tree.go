@@ -40,19 +40,26 @@ func updateMaxDLRL(schemaMap map[string]*Element, maxDL, maxRL int64) {
 	}
 }
 
-func toParquetSchema(tree *Tree, treePrefix string, schemaPrefix string, schemaList *[]*parquet.SchemaElement, valueElements *[]*Element) (err error) {
+// toParquetSchema validates and appends elements of tree to schemaList. If legacy is true, LIST and MAP
+// structures are validated as per backward compatibility rules and any ConvertedType is accepted.
+func toParquetSchema(tree *Tree, treePrefix string, schemaPrefix string, schemaList *[]*parquet.SchemaElement, valueElements *[]*Element, legacy bool) (err error) {
 	tree.Range(func(name string, element *Element) bool {
-		pathInTree := name
-		if treePrefix != "" {
-			pathInTree = treePrefix + "." + name
-		}
+		pathInTree := appendPath(treePrefix, name)
 
-		if element.Type == nil && element.ConvertedType == nil && element.Children == nil {
+		if element.Type == nil && element.ConvertedType == nil && (element.Children == nil || element.Children.Length() == 0) {
 			err = fmt.Errorf("%v: group element must have children", pathInTree)
 			return false
 		}
 
-		if element.ConvertedType != nil {
+		if legacy {
+			if err = validateLegacy(element); err != nil {
+				err = fmt.Errorf("%v: %v", pathInTree, err)
+				return false
+			}
+		} else if element.Type != nil && *element.RepetitionType == parquet.FieldRepetitionType_REPEATED {
+			err = fmt.Errorf("%v: repetition type REPEATED should be used in group element", pathInTree)
+			return false
+		} else if element.ConvertedType != nil {
 			switch *element.ConvertedType {
 			case parquet.ConvertedType_LIST:
 				// Supported structure.
@@ -183,6 +190,14 @@ func toParquetSchema(tree *Tree, treePrefix string, schemaPrefix string, schemaL
 			case parquet.ConvertedType_UINT_32, parquet.ConvertedType_UINT_64, parquet.ConvertedType_INT_8:
 				fallthrough
 			case parquet.ConvertedType_INT_16, parquet.ConvertedType_INT_32, parquet.ConvertedType_INT_64:
+				fallthrough
+			case parquet.ConvertedType_DECIMAL, parquet.ConvertedType_DATE, parquet.ConvertedType_ENUM:
+				fallthrough
+			case parquet.ConvertedType_TIME_MILLIS, parquet.ConvertedType_TIME_MICROS:
+				fallthrough
+			case parquet.ConvertedType_TIMESTAMP_MILLIS, parquet.ConvertedType_TIMESTAMP_MICROS:
+				fallthrough
+			case parquet.ConvertedType_JSON, parquet.ConvertedType_BSON, parquet.ConvertedType_INTERVAL:
 				if element.Type == nil {
 					err = fmt.Errorf("%v: ConvertedType %v must have Type value", pathInTree, element.ConvertedType)
 					return false
@@ -195,10 +210,7 @@ func toParquetSchema(tree *Tree, treePrefix string, schemaPrefix string, schemaL
 		}
 
 		element.PathInTree = pathInTree
-		element.PathInSchema = element.Name
-		if schemaPrefix != "" {
-			element.PathInSchema = schemaPrefix + "." + element.Name
-		}
+		element.PathInSchema = appendPath(schemaPrefix, element.Name)
 
 		if element.Type != nil {
 			*valueElements = append(*valueElements, element)
@@ -207,7 +219,7 @@ func toParquetSchema(tree *Tree, treePrefix string, schemaPrefix string, schemaL
 		*schemaList = append(*schemaList, &element.SchemaElement)
 		if element.Children != nil {
 			element.numChildren = int32(element.Children.Length())
-			err = toParquetSchema(element.Children, element.PathInTree, element.PathInSchema, schemaList, valueElements)
+			err = toParquetSchema(element.Children, element.PathInTree, element.PathInSchema, schemaList, valueElements, legacy)
 		}
 
 		return (err == nil)
@@ -216,11 +228,20 @@ func toParquetSchema(tree *Tree, treePrefix string, schemaPrefix string, schemaL
 	return err
 }
 
+// defaultRootName is name of root element of a tree without name.
+const defaultRootName = "schema"
+
 // Tree - represents tree of schema.  Tree preserves order in which elements are added.
 type Tree struct {
 	schemaMap map[string]*Element
 	keys      []string
 	readOnly  bool
+	name      string
+	legacy    bool // Accepts legacy LIST and MAP structures; set for trees read from files.
+
+	// Result of ToParquetSchema kept for ParquetSchema of read only tree.
+	schemaList    []*parquet.SchemaElement
+	valueElements []*Element
 }
 
 // String - stringify this tree.
@@ -265,9 +286,37 @@ func (tree *Tree) ReadOnly() bool {
 	return tree.readOnly
 }
 
-// Get - returns the element stored for name.
+// Clone - returns writable copy of this tree. Elements are copied, hence ToParquetSchema of the copy
+// neither makes this tree read only nor changes its elements.
+func (tree *Tree) Clone() *Tree {
+	clone := &Tree{
+		schemaMap: make(map[string]*Element, len(tree.schemaMap)),
+		keys:      append([]string(nil), tree.keys...),
+		name:      tree.name,
+		legacy:    tree.legacy,
+	}
+
+	for name, element := range tree.schemaMap {
+		elementClone := *element
+		if element.NumChildren == &element.numChildren {
+			elementClone.NumChildren = &elementClone.numChildren
+		}
+		if element.Children != nil {
+			elementClone.Children = element.Children.Clone()
+		}
+		clone.schemaMap[name] = &elementClone
+	}
+
+	return clone
+}
+
+// Get - returns the element stored for name. name is escaped string form of path; see JoinPath.
 func (tree *Tree) Get(name string) (element *Element, ok bool) {
-	pathSegments := strings.Split(name, ".")
+	return tree.GetPath(SplitPath(name))
+}
+
+// GetPath - returns the element stored for path.
+func (tree *Tree) GetPath(pathSegments []string) (element *Element, ok bool) {
 	for _, pathSegment := range pathSegments {
 		if tree == nil {
 			element = nil
@@ -285,29 +334,35 @@ func (tree *Tree) Get(name string) (element *Element, ok bool) {
 	return element, ok
 }
 
-// Set - adds or sets element to name.
+// Set - adds or sets element to name. name is escaped string form of path; see JoinPath.
 func (tree *Tree) Set(name string, element *Element) error {
+	return tree.SetPath(SplitPath(name), element)
+}
+
+// SetPath - adds or sets element to path.
+func (tree *Tree) SetPath(pathSegments []string, element *Element) error {
 	if tree.readOnly {
 		return fmt.Errorf("read only tree")
 	}
 
-	pathSegments := strings.Split(name, ".")
-	if err := validataPathSegments(pathSegments); err != nil {
-		return err
+	for _, pathSegment := range pathSegments {
+		if err := validateName(pathSegment); err != nil {
+			return err
+		}
 	}
 
 	i, pathSegment, currElement, parentTree, found := tree.travel(pathSegments)
 
 	if !found {
 		if i != len(pathSegments)-1 {
-			return fmt.Errorf("parent %v does not exist", strings.Join(pathSegments[:i+1], "."))
+			return fmt.Errorf("parent %v does not exist", JoinPath(pathSegments[:i+1]))
 		}
 
 		if currElement == nil {
 			parentTree = tree
 		} else {
 			if currElement.Type != nil {
-				return fmt.Errorf("parent %v is not group element", strings.Join(pathSegments[:i], "."))
+				return fmt.Errorf("parent %v is not group element", JoinPath(pathSegments[:i]))
 			}
 
 			if currElement.Children == nil {
@@ -323,14 +378,17 @@ func (tree *Tree) Set(name string, element *Element) error {
 	return nil
 }
 
-// Delete - deletes name and its element.
+// Delete - deletes name and its element. name is escaped string form of path; see JoinPath.
 func (tree *Tree) Delete(name string) {
+	tree.DeletePath(SplitPath(name))
+}
+
+// DeletePath - deletes path and its element.
+func (tree *Tree) DeletePath(pathSegments []string) {
 	if tree.readOnly {
 		panic(fmt.Errorf("read only tree"))
 	}
 
-	pathSegments := strings.Split(name, ".")
-
 	_, pathSegment, _, parentTree, found := tree.travel(pathSegments)
 
 	if found {
@@ -355,24 +413,93 @@ func (tree *Tree) Range(f func(name string, element *Element) bool) {
 	}
 }
 
+// validateLegacy validates element as per backward compatibility rules of LIST and MAP structures.
+func validateLegacy(element *Element) (err error) {
+	if element.ConvertedType == nil {
+		return nil
+	}
+
+	switch *element.ConvertedType {
+	case parquet.ConvertedType_LIST:
+		_, err = element.ListElement()
+	case parquet.ConvertedType_MAP, parquet.ConvertedType_MAP_KEY_VALUE:
+		// Repeated group of key and value may be annotated by MAP_KEY_VALUE in legacy structure.
+		if *element.RepetitionType != parquet.FieldRepetitionType_REPEATED {
+			_, _, err = element.MapKeyValue()
+		}
+	}
+
+	return err
+}
+
+// checkFieldIDs returns error if field ID of any element is already used by another element in paths.
+func checkFieldIDs(tree *Tree, paths map[int32]string) (err error) {
+	tree.Range(func(name string, element *Element) bool {
+		if element.FieldID != nil {
+			if path, found := paths[*element.FieldID]; found {
+				err = fmt.Errorf("%v: field ID %v is already used by %v", element.PathInTree, *element.FieldID, path)
+				return false
+			}
+			paths[*element.FieldID] = element.PathInTree
+		}
+
+		if element.Children != nil {
+			err = checkFieldIDs(element.Children, paths)
+		}
+
+		return (err == nil)
+	})
+
+	return err
+}
+
 // ToParquetSchema - returns list of parquet SchemaElement and list of elements those stores values.
 func (tree *Tree) ToParquetSchema() (schemaList []*parquet.SchemaElement, valueElements []*Element, err error) {
 	if tree.readOnly {
 		return nil, nil, fmt.Errorf("read only tree")
 	}
 
+	if schemaList, valueElements, err = tree.convert(); err != nil {
+		return nil, nil, err
+	}
+
+	tree.readOnly = true
+	tree.schemaList = schemaList
+	tree.valueElements = valueElements
+
+	return schemaList, valueElements, nil
+}
+
+// ParquetSchema - returns result of ToParquetSchema of read only tree, or calls ToParquetSchema of writable tree.
+func (tree *Tree) ParquetSchema() (schemaList []*parquet.SchemaElement, valueElements []*Element, err error) {
+	if !tree.readOnly {
+		return tree.ToParquetSchema()
+	}
+
+	return tree.schemaList, tree.valueElements, nil
+}
+
+// convert converts the tree to list of parquet SchemaElement without making it read only.
+func (tree *Tree) convert() (schemaList []*parquet.SchemaElement, valueElements []*Element, err error) {
 	updateMaxDLRL(tree.schemaMap, 0, 0)
 
 	var schemaElements []*parquet.SchemaElement
-	if err = toParquetSchema(tree, "", "", &schemaElements, &valueElements); err != nil {
+	if err = toParquetSchema(tree, "", "", &schemaElements, &valueElements, tree.legacy); err != nil {
 		return nil, nil, err
 	}
 
-	tree.readOnly = true
+	if err = checkFieldIDs(tree, make(map[int32]string)); err != nil {
+		return nil, nil, err
+	}
+
+	name := tree.name
+	if name == "" {
+		name = defaultRootName
+	}
 
 	numChildren := int32(len(tree.keys))
 	schemaList = append(schemaList, &parquet.SchemaElement{
-		Name:           "schema",
+		Name:           name,
 		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED),
 		NumChildren:    &numChildren,
 	})
@@ -380,6 +507,75 @@ func (tree *Tree) ToParquetSchema() (schemaList []*parquet.SchemaElement, valueE
 	return schemaList, valueElements, nil
 }
 
+// fromParquetSchema builds tree of numChildren elements from the beginning of schemaList and returns remaining list.
+func fromParquetSchema(schemaList []*parquet.SchemaElement, numChildren int32, treePrefix, schemaPrefix string) (*Tree, []*parquet.SchemaElement, error) {
+	tree := NewTree()
+	for i := int32(0); i < numChildren; i++ {
+		if len(schemaList) == 0 {
+			return nil, nil, fmt.Errorf("%v: expected %v children, got %v", schemaPrefix, numChildren, i)
+		}
+
+		schemaElement := schemaList[0]
+		schemaList = schemaList[1:]
+		if schemaElement == nil || schemaElement.RepetitionType == nil {
+			return nil, nil, fmt.Errorf("%v: repetition type of child %v not set", schemaPrefix, i)
+		}
+
+		if _, found := tree.schemaMap[schemaElement.Name]; found {
+			return nil, nil, fmt.Errorf("%v: duplicate child %v", schemaPrefix, schemaElement.Name)
+		}
+
+		element := &Element{SchemaElement: *schemaElement}
+		if schemaElement.NumChildren != nil {
+			element.numChildren = *schemaElement.NumChildren
+			element.NumChildren = &element.numChildren
+		}
+
+		element.PathInTree = appendPath(treePrefix, schemaElement.Name)
+		element.PathInSchema = appendPath(schemaPrefix, schemaElement.Name)
+
+		if element.numChildren > 0 {
+			if element.Type != nil {
+				return nil, nil, fmt.Errorf("%v: type should be nil for group element", element.PathInSchema)
+			}
+
+			var err error
+			if element.Children, schemaList, err = fromParquetSchema(schemaList, element.numChildren, element.PathInTree, element.PathInSchema); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		tree.keys = append(tree.keys, schemaElement.Name)
+		tree.schemaMap[schemaElement.Name] = element
+	}
+
+	return tree, schemaList, nil
+}
+
+// FromParquetSchema - creates tree from list of parquet SchemaElement. It is inverse of ToParquetSchema;
+// MaxDefinitionLevel, MaxRepetitionLevel, PathInTree and PathInSchema of elements are set. Legacy LIST
+// and MAP structures written by other implementations are accepted by ToParquetSchema of returned tree.
+func FromParquetSchema(schemaList []*parquet.SchemaElement) (*Tree, error) {
+	if len(schemaList) == 0 || schemaList[0] == nil {
+		return nil, fmt.Errorf("root element not found")
+	}
+
+	rootName := schemaList[0].Name
+	tree, schemaList, err := fromParquetSchema(schemaList[1:], schemaList[0].GetNumChildren(), "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(schemaList) != 0 {
+		return nil, fmt.Errorf("%v elements are not reachable from root element", len(schemaList))
+	}
+
+	tree.name = rootName
+	tree.legacy = true
+	updateMaxDLRL(tree.schemaMap, 0, 0)
+	return tree, nil
+}
+
 // NewTree - creates new schema tree.
 func NewTree() *Tree {
 	return &Tree{
@@ -17,6 +17,7 @@
 package schema
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/minio/parquet-go/gen-go/parquet"
@@ -246,6 +247,18 @@ func TestTreeToParquetSchema(t *testing.T) {
 		}
 	}
 
+	case6Root := NewTree()
+	{
+		a, err := NewElement("a", parquet.FieldRepetitionType_OPTIONAL, nil, nil, nil, nil, NewTree())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := case6Root.Set("A", a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	testCases := []struct {
 		tree      *Tree
 		expectErr bool
@@ -255,6 +268,7 @@ func TestTreeToParquetSchema(t *testing.T) {
 		{case3Root, true}, // err: A: unsupported ConvertedType MAP_KEY_VALUE
 		{case4Root, false},
 		{case5Root, false},
+		{case6Root, true}, // err: A: group element must have children
 	}
 
 	for i, testCase := range testCases {
@@ -271,6 +285,159 @@ func TestTreeToParquetSchema(t *testing.T) {
 	}
 }
 
+func TestTreeToParquetSchemaFieldIDs(t *testing.T) {
+	newTree := func(aFieldID, bFieldID int32) *Tree {
+		a, err := NewElement("a", parquet.FieldRepetitionType_OPTIONAL, nil, nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := NewElement("b", parquet.FieldRepetitionType_OPTIONAL,
+			parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tree := NewTree()
+		if err := tree.Set("a", a.SetFieldID(aFieldID)); err != nil {
+			t.Fatal(err)
+		}
+		if err := tree.Set("a.b", b.SetFieldID(bFieldID)); err != nil {
+			t.Fatal(err)
+		}
+
+		return tree
+	}
+
+	testCases := []struct {
+		tree             *Tree
+		expectedFieldIDs []int32
+		expectErr        bool
+	}{
+		{newTree(1, 2), []int32{1, 2}, false},
+		{newTree(1, 1), nil, true}, // err: a.b: field ID 1 is already used by a
+	}
+
+	for i, testCase := range testCases {
+		schemaList, _, err := testCase.tree.ToParquetSchema()
+		expectErr := (err != nil)
+
+		if expectErr != testCase.expectErr {
+			if testCase.expectErr {
+				t.Fatalf("case %v: err: expected: <error>, got: <nil>", i+1)
+			} else {
+				t.Fatalf("case %v: err: expected: <nil>, got: %v", i+1, err)
+			}
+		}
+
+		if expectErr {
+			continue
+		}
+
+		var fieldIDs []int32
+		for _, element := range schemaList[1:] {
+			fieldIDs = append(fieldIDs, element.GetFieldID())
+		}
+
+		if !reflect.DeepEqual(fieldIDs, testCase.expectedFieldIDs) {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedFieldIDs, fieldIDs)
+		}
+	}
+}
+
+func TestTreeReadOnly(t *testing.T) {
+	tree := NewTree()
+	a, err := NewElement("a", parquet.FieldRepetitionType_REQUIRED, parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tree.Set("a", a); err != nil {
+		t.Fatal(err)
+	}
+
+	schemaList, valueElements, err := tree.ParquetSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !tree.ReadOnly() {
+		t.Fatalf("expected: read only tree, got: writable tree")
+	}
+
+	if _, _, err = tree.ToParquetSchema(); err == nil {
+		t.Fatalf("ToParquetSchema: expected: <error>, got: <nil>")
+	}
+
+	if err = tree.Set("b", a); err == nil {
+		t.Fatalf("Set: expected: <error>, got: <nil>")
+	}
+
+	resultSchemaList, resultValueElements, err := tree.ParquetSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(resultSchemaList, schemaList) || !reflect.DeepEqual(resultValueElements, valueElements) {
+		t.Fatalf("expected: %v %v, got: %v %v", schemaList, valueElements, resultSchemaList, resultValueElements)
+	}
+}
+
+func TestTreeClone(t *testing.T) {
+	b, err := NewElement("b", parquet.FieldRepetitionType_REQUIRED, parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	children := NewTree()
+	if err = children.Set("b", b); err != nil {
+		t.Fatal(err)
+	}
+	a, err := NewElement("a", parquet.FieldRepetitionType_OPTIONAL, nil, nil, nil, nil, children)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := NewTree()
+	if err = tree.Set("a", a); err != nil {
+		t.Fatal(err)
+	}
+
+	clone := tree.Clone()
+	cloneSchemaList, _, err := clone.ToParquetSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tree.ReadOnly() || !clone.ReadOnly() {
+		t.Fatalf("read only: expected: false, true, got: %v, %v", tree.ReadOnly(), clone.ReadOnly())
+	}
+
+	if b.MaxDefinitionLevel != 0 || b.PathInSchema != "" {
+		t.Fatalf("element b: expected: unchanged, got: %v", b)
+	}
+
+	// Tree is still writable, and its child added after cloning is not in the clone.
+	c, err := NewElement("c", parquet.FieldRepetitionType_REQUIRED, parquet.TypePtr(parquet.Type_INT64), nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tree.Set("a.c", c); err != nil {
+		t.Fatal(err)
+	}
+
+	schemaList, _, err := tree.ToParquetSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(schemaList) != 4 || len(cloneSchemaList) != 3 {
+		t.Fatalf("schema elements: expected: 4, 3, got: %v, %v", len(schemaList), len(cloneSchemaList))
+	}
+
+	if n, cloneN := schemaList[1].GetNumChildren(), cloneSchemaList[1].GetNumChildren(); n != 2 || cloneN != 1 {
+		t.Fatalf("children of a: expected: 2, 1, got: %v, %v", n, cloneN)
+	}
+}
+
 func TestTreeToParquetSchemaOfList(t *testing.T) {
 	case1Root := NewTree()
 	{
@@ -1090,3 +1257,107 @@ func TestTreeToParquetSchemaOfMap(t *testing.T) {
 		}
 	}
 }
+
+func TestFromParquetSchema(t *testing.T) {
+	newTree := func() *Tree {
+		id, err := NewElement("id", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_INT64), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		address, err := NewElement("address", parquet.FieldRepetitionType_OPTIONAL, nil, nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		city, err := NewElement("city", parquet.FieldRepetitionType_OPTIONAL,
+			parquet.TypePtr(parquet.Type_BYTE_ARRAY), parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8),
+			nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tags, err := NewElement("tags", parquet.FieldRepetitionType_OPTIONAL,
+			nil, parquet.ConvertedTypePtr(parquet.ConvertedType_LIST), nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		list, err := NewElement("list", parquet.FieldRepetitionType_REPEATED, nil, nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		element, err := NewElement("element", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tree := NewTree()
+		for _, pair := range []struct {
+			name    string
+			element *Element
+		}{
+			{"id", id},
+			{"address", address},
+			{"address.city", city},
+			{"tags", tags},
+			{"tags.list", list},
+			{"tags.list.element", element},
+		} {
+			if err := tree.Set(pair.name, pair.element); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return tree
+	}
+
+	schemaList, valueElements, err := newTree().ToParquetSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tree, err := FromParquetSchema(schemaList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tree.ReadOnly() {
+		t.Fatalf("expected: writable tree, got: read only tree")
+	}
+
+	for _, valueElement := range valueElements {
+		element, ok := tree.Get(valueElement.PathInTree)
+		if !ok {
+			t.Fatalf("%v: not found", valueElement.PathInTree)
+		}
+
+		if element.String() != valueElement.String() {
+			t.Fatalf("%v: expected: %v, got: %v", valueElement.PathInTree, valueElement, element)
+		}
+	}
+
+	resultSchemaList, _, err := tree.ToParquetSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(resultSchemaList, schemaList) {
+		t.Fatalf("expected: %v, got: %v", schemaList, resultSchemaList)
+	}
+
+	testCases := [][]*parquet.SchemaElement{
+		nil,
+		schemaList[:3], // address group has missing child.
+		append(append([]*parquet.SchemaElement{}, schemaList...), schemaList[1]), // unreachable element.
+	}
+
+	for i, testCase := range testCases {
+		if _, err := FromParquetSchema(testCase); err == nil {
+			t.Fatalf("case %v: expected: <error>, got: <nil>", i+1)
+		}
+	}
+}
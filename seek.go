@@ -0,0 +1,156 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/minio/parquet-go/encryption"
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// readOffsetIndex reads page locations of column chunk from its offset index. It returns nil if the
+// column chunk has no offset index.
+func readOffsetIndex(getReaderFunc GetReaderFunc, columnChunk *parquet.ColumnChunk, cipher *encryption.Cipher, rowGroupOrdinal, columnOrdinal int16, limits *Limits) ([]*parquet.PageLocation, error) {
+	if !columnChunk.IsSetOffsetIndexOffset() || !columnChunk.IsSetOffsetIndexLength() {
+		return nil, nil
+	}
+
+	length := int64(columnChunk.GetOffsetIndexLength())
+	if length <= 0 {
+		return nil, fmt.Errorf("invalid offset index length %v", length)
+	}
+
+	if maxSize := limits.maxPageSize(); maxSize > 0 && length > maxSize {
+		return nil, fmt.Errorf("%w: offset index size %v exceeds %v", ErrLimitExceeded, length, maxSize)
+	}
+
+	data, err := readFull(getReaderFunc, columnChunk.GetOffsetIndexOffset(), length)
+	if err != nil {
+		return nil, fmt.Errorf("offset index: %w", err)
+	}
+
+	if cipher != nil {
+		if data, err = cipher.Decrypt(encryption.OffsetIndex, rowGroupOrdinal, columnOrdinal, 0, data); err != nil {
+			return nil, &DecryptionError{Err: err}
+		}
+	}
+
+	offsetIndex := parquet.NewOffsetIndex()
+	if _, err = readThriftStruct(data, offsetIndex); err != nil {
+		return nil, fmt.Errorf("offset index: %w", err)
+	}
+
+	return offsetIndex.GetPageLocations(), nil
+}
+
+// pageSeek - denotes data page containing a row.
+type pageSeek struct {
+	index       int   // Index of the page in data pages of the column chunk.
+	offset      int64 // File offset of the page.
+	firstOffset int64 // File offset of first data page.
+	firstRow    int64 // Index of first row of the page in the row group.
+}
+
+// findPage returns data page of locations containing row of the row group, whose column chunk spans
+// from startOffset to endOffset. It returns nil if the row is in first page i.e. no page is skipped.
+func findPage(locations []*parquet.PageLocation, row, startOffset, endOffset int64) (*pageSeek, error) {
+	for i, location := range locations {
+		if location == nil {
+			return nil, errors.New("invalid offset index: missing page location")
+		}
+
+		valid := location.GetOffset() >= startOffset && location.GetOffset() < endOffset
+		if i == 0 {
+			valid = valid && location.GetFirstRowIndex() == 0
+		} else {
+			valid = valid && location.GetOffset() > locations[i-1].GetOffset() &&
+				location.GetFirstRowIndex() > locations[i-1].GetFirstRowIndex()
+		}
+
+		if !valid {
+			return nil, fmt.Errorf("invalid offset index: page location %v", i)
+		}
+	}
+
+	i := sort.Search(len(locations), func(i int) bool {
+		return locations[i].GetFirstRowIndex() > row
+	}) - 1
+	if i <= 0 {
+		return nil, nil
+	}
+
+	return &pageSeek{
+		index:       i,
+		offset:      locations[i].GetOffset(),
+		firstOffset: locations[0].GetOffset(),
+		firstRow:    locations[i].GetFirstRowIndex(),
+	}, nil
+}
+
+// SeekToRow - sets next record read by Read to row of index row in the file. Row groups before the row are
+// not read. Pages before the row are not read if its column chunk has offset index, otherwise values before
+// the row are decoded and skipped. Seeking to number of rows in the file makes Read return io.EOF. Reader of
+// NewReaderForSplit seeks to rows of its split only.
+func (reader *Reader) SeekToRow(row int64) error {
+	firstRow, endRow := reader.firstRow(reader.rowGroupStart), reader.firstRow(reader.rowGroupEnd)
+	if row < firstRow || row > endRow {
+		return fmt.Errorf("row %v out of range [%v, %v]", row, firstRow, endRow)
+	}
+
+	reader.closeColumns()
+
+	row -= firstRow
+	reader.rowGroupIndex = reader.rowGroupStart
+	for reader.rowGroupIndex < reader.rowGroupEnd && row >= reader.rowGroups[reader.rowGroupIndex].GetNumRows() {
+		row -= reader.rowGroups[reader.rowGroupIndex].GetNumRows()
+		reader.rowGroupIndex++
+	}
+
+	reader.seekRow = row
+	return nil
+}
+
+// ReadRange - reads records of rows from start till end excluding end. Fewer records are returned if the
+// file ends before end. Next Read reads row end.
+func (reader *Reader) ReadRange(start, end int64) ([]*Record, error) {
+	if start > end {
+		return nil, fmt.Errorf("invalid row range [%v, %v)", start, end)
+	}
+
+	if err := reader.SeekToRow(start); err != nil {
+		return nil, err
+	}
+
+	var records []*Record
+	for row := start; row < end; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,335 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/minio/parquet-go/data"
+	"github.com/minio/parquet-go/gen-go/parquet"
+	"github.com/minio/parquet-go/schema"
+)
+
+func TestFindPage(t *testing.T) {
+	locations := []*parquet.PageLocation{
+		{Offset: 10, CompressedPageSize: 20, FirstRowIndex: 0},
+		{Offset: 30, CompressedPageSize: 20, FirstRowIndex: 5},
+		{Offset: 50, CompressedPageSize: 20, FirstRowIndex: 12},
+	}
+
+	testCases := []struct {
+		locations    []*parquet.PageLocation
+		row          int64
+		expectedSeek *pageSeek
+		expectErr    bool
+	}{
+		{locations, 0, nil, false},
+		{locations, 4, nil, false},
+		{locations, 5, &pageSeek{index: 1, offset: 30, firstOffset: 10, firstRow: 5}, false},
+		{locations, 11, &pageSeek{index: 1, offset: 30, firstOffset: 10, firstRow: 5}, false},
+		{locations, 20, &pageSeek{index: 2, offset: 50, firstOffset: 10, firstRow: 12}, false},
+		{nil, 20, nil, false},
+		{[]*parquet.PageLocation{{Offset: 10, FirstRowIndex: 1}}, 20, nil, true},
+		{[]*parquet.PageLocation{{Offset: 5}}, 20, nil, true},
+		{[]*parquet.PageLocation{{Offset: 10}, {Offset: 70, FirstRowIndex: 5}}, 20, nil, true},
+		{[]*parquet.PageLocation{{Offset: 30}, {Offset: 10, FirstRowIndex: 5}}, 20, nil, true},
+		{[]*parquet.PageLocation{{Offset: 10}, {Offset: 30, FirstRowIndex: 0}}, 20, nil, true},
+		{[]*parquet.PageLocation{{Offset: 10}, nil}, 20, nil, true},
+	}
+
+	for i, testCase := range testCases {
+		seek, err := findPage(testCase.locations, testCase.row, 10, 70)
+		if expectErr := (err != nil); expectErr != testCase.expectErr {
+			t.Fatalf("case %v: error: expected: %v, got: %v", i+1, testCase.expectErr, expectErr)
+		}
+
+		if !reflect.DeepEqual(seek, testCase.expectedSeek) {
+			t.Fatalf("case %v: seek: expected: %+v, got: %+v", i+1, testCase.expectedSeek, seek)
+		}
+	}
+}
+
+// writeSeekFile writes file of 10 rows {"id": i, "a": [i, 10*i]} in row groups of 4 rows.
+func writeSeekFile(t *testing.T) []byte {
+	// message schema { required int32 id; optional group a (LIST) { repeated int32 array; } }
+	required := parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	optional := parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL)
+	repeated := parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REPEATED)
+	numChildren := func(n int32) *int32 { return &n }
+	schemaTree, err := schema.FromParquetSchema([]*parquet.SchemaElement{
+		{Name: "schema", NumChildren: numChildren(2)},
+		{Name: "id", RepetitionType: required, Type: parquet.TypePtr(parquet.Type_INT32)},
+		{Name: "a", RepetitionType: optional, NumChildren: numChildren(1), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_LIST)},
+		{Name: "array", RepetitionType: repeated, Type: parquet.TypePtr(parquet.Type_INT32)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"id", "a.array"} {
+		element, _ := schemaTree.Get(name)
+		element.Encoding = parquet.EncodingPtr(parquet.Encoding_PLAIN)
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := int32(0); i < 10; i++ {
+		id := data.NewColumn(parquet.Type_INT32)
+		id.AddInt32(i, 0, 0)
+
+		array := data.NewColumn(parquet.Type_INT32)
+		array.AddInt32(i, 2, 0)
+		array.AddInt32(10*i, 2, 1)
+
+		if err = writer.Write(map[string]*data.Column{"id": id, "a.array": array}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func recordString(record *Record) string {
+	id, _ := record.Get("id")
+	a, _ := record.Get("a")
+	return fmt.Sprint(id.Value, a.Value)
+}
+
+func TestReaderSeekToRow(t *testing.T) {
+	reader, err := NewBytesReader(writeSeekFile(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	// Rows are read in reverse order to seek backwards within and across row groups.
+	for row := int64(9); row >= 0; row-- {
+		if err = reader.SeekToRow(row); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := row; i < 10; i++ {
+			record, err := reader.Read()
+			if err != nil {
+				t.Fatalf("seek %v: row %v: %v", row, i, err)
+			}
+
+			expected := fmt.Sprint(i, []interface{}{int32(i), int32(10 * i)})
+			if result := recordString(record); result != expected {
+				t.Fatalf("seek %v: row %v: expected: %v, got: %v", row, i, expected, result)
+			}
+		}
+
+		if _, err = reader.Read(); err != io.EOF {
+			t.Fatalf("seek %v: expected: %v, got: %v", row, io.EOF, err)
+		}
+	}
+
+	if err = reader.SeekToRow(10); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = reader.Read(); err != io.EOF {
+		t.Fatalf("expected: %v, got: %v", io.EOF, err)
+	}
+
+	for _, row := range []int64{-1, 11} {
+		if err = reader.SeekToRow(row); err == nil {
+			t.Fatalf("seek %v: expected: <error>, got: <nil>", row)
+		}
+	}
+}
+
+func TestReaderReadRange(t *testing.T) {
+	reader, err := NewBytesReader(writeSeekFile(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	testCases := []struct {
+		start, end  int64
+		expectedIDs []int32
+		expectErr   bool
+	}{
+		{3, 7, []int32{3, 4, 5, 6}, false},
+		{0, 2, []int32{0, 1}, false},
+		{8, 20, []int32{8, 9}, false},
+		{5, 5, nil, false},
+		{10, 12, nil, false},
+		{5, 4, nil, true},
+		{11, 12, nil, true},
+	}
+
+	for i, testCase := range testCases {
+		records, err := reader.ReadRange(testCase.start, testCase.end)
+		if expectErr := (err != nil); expectErr != testCase.expectErr {
+			t.Fatalf("case %v: error: expected: %v, got: %v", i+1, testCase.expectErr, err)
+		}
+
+		var ids []int32
+		for _, record := range records {
+			id, _ := record.Get("id")
+			ids = append(ids, id.Value.(int32))
+		}
+
+		if !reflect.DeepEqual(ids, testCase.expectedIDs) {
+			t.Fatalf("case %v: expected: %v, got: %v", i+1, testCase.expectedIDs, ids)
+		}
+	}
+}
+
+// writeOffsetIndexFile writes file of single column "a" of rows 0 to 9 whose column chunk has pages of
+// 3, 3, 3 and 1 rows and offset index. Row groups of a file of single column are contiguous, hence they
+// are merged into a row group of a column chunk of their pages.
+func writeOffsetIndexFile(t *testing.T) (fileData []byte, locations []*parquet.PageLocation) {
+	a, err := schema.NewElement("a", parquet.FieldRepetitionType_REQUIRED,
+		parquet.TypePtr(parquet.Type_INT32), nil, parquet.EncodingPtr(parquet.Encoding_PLAIN), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaTree := schema.NewTree()
+	if err = schemaTree.Set("a", a); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if err = writer.WriteJSON([]byte(fmt.Sprintf(`{"a": %v}`, i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fileData = buf.Bytes()
+	footerSize := int(binary.LittleEndian.Uint32(fileData[len(fileData)-8:]))
+	footerOffset := len(fileData) - 8 - footerSize
+	fileMeta, err := UnmarshalFileMetaData(fileData[footerOffset : len(fileData)-8])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chunk := fileMeta.RowGroups[0].Columns[0]
+	var firstRow int64
+	for _, rowGroup := range fileMeta.RowGroups {
+		meta := rowGroup.Columns[0].MetaData
+		locations = append(locations, &parquet.PageLocation{
+			Offset:             meta.DataPageOffset,
+			CompressedPageSize: int32(meta.TotalCompressedSize),
+			FirstRowIndex:      firstRow,
+		})
+		firstRow += rowGroup.NumRows
+
+		if rowGroup != fileMeta.RowGroups[0] {
+			chunk.MetaData.NumValues += meta.NumValues
+			chunk.MetaData.TotalCompressedSize += meta.TotalCompressedSize
+			chunk.MetaData.TotalUncompressedSize += meta.TotalUncompressedSize
+			fileMeta.RowGroups[0].NumRows += rowGroup.NumRows
+			fileMeta.RowGroups[0].TotalByteSize += rowGroup.TotalByteSize
+		}
+	}
+	fileMeta.RowGroups = fileMeta.RowGroups[:1]
+
+	offsetIndexData, err := serializeThrift(&parquet.OffsetIndex{PageLocations: locations})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	offsetIndexOffset := int64(footerOffset)
+	offsetIndexLength := int32(len(offsetIndexData))
+	chunk.OffsetIndexOffset = &offsetIndexOffset
+	chunk.OffsetIndexLength = &offsetIndexLength
+
+	footer, err := MarshalFileMetaData(fileMeta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileData = append(append([]byte{}, fileData[:footerOffset]...), offsetIndexData...)
+	fileData = append(fileData, footer...)
+	fileData = append(fileData, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(fileData[len(fileData)-4:], uint32(len(footer)))
+	return append(fileData, "PAR1"...), locations
+}
+
+func TestReaderSeekOffsetIndex(t *testing.T) {
+	fileData, locations := writeOffsetIndexFile(t)
+	getReaderFunc := bytesGetReaderFunc(fileData)
+
+	for row := int64(0); row <= 10; row++ {
+		var offsets []int64
+		countingGetReaderFunc := func(offset, length int64) (io.ReadCloser, error) {
+			offsets = append(offsets, offset)
+			return getReaderFunc(offset, length)
+		}
+
+		reader, err := NewReader(countingGetReaderFunc, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = reader.SeekToRow(row); err != nil {
+			t.Fatal(err)
+		}
+
+		offsets = nil
+		for i := row; i < 10; i++ {
+			record, err := reader.Read()
+			if err != nil {
+				t.Fatalf("seek %v: row %v: %v", row, i, err)
+			}
+
+			if a, _ := record.Get("a"); a.Value != int32(i) {
+				t.Fatalf("seek %v: row %v: expected: %v, got: %v", row, i, i, a.Value)
+			}
+		}
+
+		if _, err = reader.Read(); err != io.EOF {
+			t.Fatalf("seek %v: expected: %v, got: %v", row, io.EOF, err)
+		}
+
+		// Column chunk is read from the page containing the row.
+		if row > 0 && row < 10 {
+			page := locations[row/3]
+			if offsets[len(offsets)-1] != page.Offset {
+				t.Fatalf("seek %v: read offset: expected: %v, got: %v", row, page.Offset, offsets[len(offsets)-1])
+			}
+		}
+
+		reader.Close()
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// Split - denotes contiguous row groups of a file to be read by reader of NewReaderForSplit. It is
+// serializable e.g. by encoding/json to be sent to other processes.
+type Split struct {
+	RowGroupStart int   // Index of first row group.
+	RowGroupEnd   int   // Index of row group after last row group.
+	Offset        int64 // File offset of column chunks of the row groups.
+	Length        int64 // Size of column chunks of the row groups in bytes.
+}
+
+// rowGroupRange returns file offset and size of column chunks of row group.
+func rowGroupRange(rowGroup *parquet.RowGroup) (offset, size int64, err error) {
+	start, end := int64(-1), int64(-1)
+	for _, columnChunk := range rowGroup.GetColumns() {
+		// Metadata of column encrypted with unavailable key is missing.
+		meta := columnChunk.GetMetaData()
+		if meta == nil {
+			continue
+		}
+
+		chunkStart := meta.GetDataPageOffset()
+		if meta.IsSetDictionaryPageOffset() && meta.GetDictionaryPageOffset() < chunkStart {
+			chunkStart = meta.GetDictionaryPageOffset()
+		}
+
+		if start < 0 || chunkStart < start {
+			start = chunkStart
+		}
+
+		if chunkEnd := chunkStart + meta.GetTotalCompressedSize(); chunkEnd > end {
+			end = chunkEnd
+		}
+	}
+
+	if start < 0 {
+		return 0, 0, errors.New("column metadata missing")
+	}
+
+	return start, end - start, nil
+}
+
+// Splits - returns splits of row groups of about targetBytes each. As in Hadoop, the file is divided into
+// byte ranges of targetBytes, and a row group belongs to the split of byte range containing its midpoint;
+// hence row groups are never divided and byte ranges without midpoint have no split. Splits of reader of
+// NewReaderForSplit are splits of its split.
+func (reader *Reader) Splits(targetBytes int64) ([]Split, error) {
+	if targetBytes <= 0 {
+		return nil, fmt.Errorf("invalid split size %v", targetBytes)
+	}
+
+	var splits []Split
+	var lastRange int64
+	for i := reader.rowGroupStart; i < reader.rowGroupEnd; i++ {
+		offset, size, err := rowGroupRange(reader.rowGroups[i])
+		if err != nil {
+			return nil, fmt.Errorf("row group %v: %w", i, err)
+		}
+
+		byteRange := (offset + size/2) / targetBytes
+		if n := len(splits); n > 0 && byteRange == lastRange {
+			splits[n-1].RowGroupEnd = i + 1
+			if end := offset + size; end > splits[n-1].Offset+splits[n-1].Length {
+				splits[n-1].Length = end - splits[n-1].Offset
+			}
+			continue
+		}
+
+		splits = append(splits, Split{
+			RowGroupStart: i,
+			RowGroupEnd:   i + 1,
+			Offset:        offset,
+			Length:        size,
+		})
+		lastRange = byteRange
+	}
+
+	return splits, nil
+}
+
+// NewReaderForSplit - creates new parquet reader like NewReader which reads row groups of split only. It fails
+// with ErrSplitMismatch if Offset and Length of split are not of its row groups, e.g. split is of another file.
+func NewReaderForSplit(getReaderFunc GetReaderFunc, split Split, columnNames set.StringSet) (*Reader, error) {
+	return NewReaderWithOptions(withContext(getReaderFunc), ReaderOptions{Columns: columnNames, Split: &split})
+}
+
+// splitRange returns file offset and length of split of rowGroups as Splits computes them.
+func splitRange(rowGroups []*parquet.RowGroup) (offset, length int64, err error) {
+	for i, rowGroup := range rowGroups {
+		rowGroupOffset, size, err := rowGroupRange(rowGroup)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if i == 0 {
+			offset = rowGroupOffset
+		}
+
+		if end := rowGroupOffset + size; end > offset+length {
+			length = end - offset
+		}
+	}
+
+	return offset, length, nil
+}
+
+// setSplit restricts rows read to row groups of split. Offset and Length of split must be of its row groups,
+// hence split of another file is rejected with ErrSplitMismatch.
+func (reader *Reader) setSplit(split Split) error {
+	if split.RowGroupStart < 0 || split.RowGroupStart > split.RowGroupEnd || split.RowGroupEnd > len(reader.rowGroups) {
+		return fmt.Errorf("split row groups [%v, %v) out of range [0, %v)",
+			split.RowGroupStart, split.RowGroupEnd, len(reader.rowGroups))
+	}
+
+	offset, length, err := splitRange(reader.rowGroups[split.RowGroupStart:split.RowGroupEnd])
+	if err != nil {
+		return fmt.Errorf("split row groups [%v, %v): %w", split.RowGroupStart, split.RowGroupEnd, err)
+	}
+
+	if split.Offset != offset || split.Length != length {
+		return fmt.Errorf("%w: split range [%v, %v), row groups range [%v, %v)", ErrSplitMismatch,
+			split.Offset, split.Offset+split.Length, offset, offset+length)
+	}
+
+	reader.rowGroupStart = split.RowGroupStart
+	reader.rowGroupIndex = split.RowGroupStart
+	reader.rowGroupEnd = split.RowGroupEnd
+	return nil
+}
@@ -0,0 +1,168 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestReaderSplits(t *testing.T) {
+	fileData := writeSeekFile(t)
+	reader, err := NewBytesReader(fileData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	var ranges [][2]int64
+	for _, rowGroup := range reader.rowGroups {
+		offset, size, err := rowGroupRange(rowGroup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ranges = append(ranges, [2]int64{offset, size})
+	}
+	if len(ranges) != 3 {
+		t.Fatalf("row groups: expected: 3, got: %v", len(ranges))
+	}
+
+	midpoint := func(i int) int64 { return ranges[i][0] + ranges[i][1]/2 }
+	split := func(start, end int) Split {
+		return Split{
+			RowGroupStart: start,
+			RowGroupEnd:   end,
+			Offset:        ranges[start][0],
+			Length:        ranges[end-1][0] + ranges[end-1][1] - ranges[start][0],
+		}
+	}
+
+	testCases := []struct {
+		targetBytes    int64
+		expectedSplits []Split
+	}{
+		{int64(len(fileData)), []Split{split(0, 3)}},
+		{1, []Split{split(0, 1), split(1, 2), split(2, 3)}},
+		// Byte ranges end just after midpoint of first and second row groups.
+		{midpoint(1) + 1, []Split{split(0, 2), split(2, 3)}},
+		{midpoint(0) + 1, []Split{split(0, 1), split(1, 2), split(2, 3)}},
+	}
+
+	for i, testCase := range testCases {
+		splits, err := reader.Splits(testCase.targetBytes)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if !reflect.DeepEqual(splits, testCase.expectedSplits) {
+			t.Fatalf("case %v: expected: %+v, got: %+v", i+1, testCase.expectedSplits, splits)
+		}
+	}
+
+	if _, err = reader.Splits(0); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+}
+
+func TestNewReaderForSplit(t *testing.T) {
+	fileData := writeSeekFile(t)
+	reader, err := NewBytesReader(fileData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	splits, err := reader.Splits(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader.Close()
+
+	var ids []int32
+	for _, split := range splits {
+		data, err := json.Marshal(split)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded Split
+		if err = json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		splitReader, err := NewReaderForSplit(bytesGetReaderFunc(fileData), decoded, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for {
+			record, err := splitReader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			id, _ := record.Get("id")
+			ids = append(ids, id.Value.(int32))
+		}
+		splitReader.Close()
+	}
+
+	if expected := []int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected: %v, got: %v", expected, ids)
+	}
+
+	// Second split has rows 4 to 7.
+	splitReader, err := NewReaderForSplit(bytesGetReaderFunc(fileData), splits[1], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer splitReader.Close()
+
+	records, err := splitReader.ReadRange(6, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("records: expected: 2, got: %v", len(records))
+	}
+
+	for _, row := range []int64{3, 9} {
+		if err = splitReader.SeekToRow(row); err == nil {
+			t.Fatalf("seek %v: expected: <error>, got: <nil>", row)
+		}
+	}
+
+	if _, err = NewReaderForSplit(bytesGetReaderFunc(fileData), Split{RowGroupStart: 2, RowGroupEnd: 4}, nil); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+
+	// Split of other offset or length, e.g. of another file, is rejected.
+	for i, split := range []Split{
+		{RowGroupStart: splits[1].RowGroupStart, RowGroupEnd: splits[1].RowGroupEnd},
+		{RowGroupStart: splits[1].RowGroupStart, RowGroupEnd: splits[1].RowGroupEnd, Offset: splits[1].Offset + 1, Length: splits[1].Length},
+		{RowGroupStart: splits[1].RowGroupStart, RowGroupEnd: splits[1].RowGroupEnd, Offset: splits[1].Offset, Length: splits[1].Length - 1},
+	} {
+		if _, err = NewReaderForSplit(bytesGetReaderFunc(fileData), split, nil); !errors.Is(err, ErrSplitMismatch) {
+			t.Fatalf("case %v: err: expected: %v, got: %v", i+1, ErrSplitMismatch, err)
+		}
+	}
+}
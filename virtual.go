@@ -0,0 +1,95 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"fmt"
+
+	"github.com/minio/parquet-go/gen-go/parquet"
+)
+
+// Virtual columns which are not stored in the file but computed by reader; see SelectVirtualColumns.
+const (
+	RowIndexColumn   = "_row_index"   // INT64 index of the row in the file.
+	RowGroupColumn   = "_row_group"   // INT32 index of row group of the row.
+	PageOffsetColumn = "_page_offset" // INT64 file offset of page of first read column containing the row.
+	FileColumn       = "_file"        // UTF8 BYTE_ARRAY of Reader.FileLabel.
+)
+
+var virtualColumnSchemas = map[string]*parquet.SchemaElement{
+	RowIndexColumn:   virtualColumnSchema(RowIndexColumn, parquet.Type_INT64, nil),
+	RowGroupColumn:   virtualColumnSchema(RowGroupColumn, parquet.Type_INT32, nil),
+	PageOffsetColumn: virtualColumnSchema(PageOffsetColumn, parquet.Type_INT64, nil),
+	FileColumn:       virtualColumnSchema(FileColumn, parquet.Type_BYTE_ARRAY, parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)),
+}
+
+func virtualColumnSchema(name string, parquetType parquet.Type, convertedType *parquet.ConvertedType) *parquet.SchemaElement {
+	return &parquet.SchemaElement{
+		Name:           name,
+		Type:           parquet.TypePtr(parquetType),
+		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED),
+		ConvertedType:  convertedType,
+	}
+}
+
+// SelectVirtualColumns - sets virtual columns added to records read by Read after selected columns of
+// the file in order of names. Names are RowIndexColumn, RowGroupColumn, PageOffsetColumn or FileColumn;
+// no name removes virtual columns. Virtual columns are not supported by files having columns of the names.
+func (reader *Reader) SelectVirtualColumns(names ...string) error {
+	for _, name := range names {
+		if _, found := virtualColumnSchemas[name]; !found {
+			return fmt.Errorf("unknown virtual column %v", name)
+		}
+
+		for _, fileName := range reader.nameList {
+			if fileName == name {
+				return fmt.Errorf("virtual column %v conflicts with column of the file", name)
+			}
+		}
+	}
+
+	reader.virtualColumns = names
+	return nil
+}
+
+// setVirtualColumns sets virtual columns of record of row of index rowIndex in current row group.
+func (reader *Reader) setVirtualColumns(record *Record, rowIndex int64) {
+	nameList := record.nameList
+	record.nameList = append(nameList[:len(nameList):len(nameList)], reader.virtualColumns...)
+
+	for _, name := range reader.virtualColumns {
+		schema := virtualColumnSchemas[name]
+		value := Value{Type: schema.GetType(), Schema: schema}
+		switch name {
+		case RowIndexColumn:
+			value.Value = reader.rowGroupFirstRow + rowIndex
+		case RowGroupColumn:
+			value.Value = int32(reader.rowGroupIndex)
+		case PageOffsetColumn:
+			for _, columnName := range reader.nameList {
+				if column, found := reader.columns[columnName]; found {
+					value.Value = column.rowPageOffset
+					break
+				}
+			}
+		case FileColumn:
+			value.Value = []byte(reader.FileLabel)
+		}
+
+		record.set(name, value)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ * Minio Cloud Storage, (C) 2019 Minio, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReaderVirtualColumns(t *testing.T) {
+	fileData, locations := writeOffsetIndexFile(t)
+	reader, err := NewBytesReader(fileData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	reader.FileLabel = "s3://bucket/object.parquet"
+	if err = reader.SelectVirtualColumns(FileColumn, RowIndexColumn, RowGroupColumn, PageOffsetColumn); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := reader.ReadRange(2, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, record := range records {
+		row := int64(i + 2)
+
+		var names []string
+		record.Range(func(name string, value Value) bool {
+			names = append(names, name)
+			return true
+		})
+		if expected := []string{"a", FileColumn, RowIndexColumn, RowGroupColumn, PageOffsetColumn}; !reflect.DeepEqual(names, expected) {
+			t.Fatalf("row %v: names: expected: %v, got: %v", row, expected, names)
+		}
+
+		expectedValues := map[string]interface{}{
+			FileColumn:       []byte(reader.FileLabel),
+			RowIndexColumn:   row,
+			RowGroupColumn:   int32(0),
+			PageOffsetColumn: locations[row/3].Offset,
+		}
+		for name, expected := range expectedValues {
+			if value, _ := record.Get(name); !reflect.DeepEqual(value.Value, expected) {
+				t.Fatalf("row %v: %v: expected: %v, got: %v", row, name, expected, value.Value)
+			}
+		}
+	}
+}
+
+func TestReaderVirtualColumnsRowGroups(t *testing.T) {
+	reader, err := NewBytesReader(writeSeekFile(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if err = reader.SelectVirtualColumns("_unknown"); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+
+	reader.SelectColumns([]string{"a", "array"})
+	if err = reader.SelectVirtualColumns(RowIndexColumn, RowGroupColumn); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		seekRow          int64
+		expectedRowGroup int32
+	}{
+		{0, 0},
+		{3, 0},
+		{4, 1},
+		{7, 1},
+		{9, 2},
+	}
+
+	for i, testCase := range testCases {
+		if err = reader.SeekToRow(testCase.seekRow); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		record, err := reader.Read()
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if value, _ := record.Get(RowIndexColumn); value.Value != testCase.seekRow {
+			t.Fatalf("case %v: row index: expected: %v, got: %v", i+1, testCase.seekRow, value.Value)
+		}
+
+		if value, _ := record.Get(RowGroupColumn); value.Value != testCase.expectedRowGroup {
+			t.Fatalf("case %v: row group: expected: %v, got: %v", i+1, testCase.expectedRowGroup, value.Value)
+		}
+
+		if _, found := record.Get("id"); found {
+			t.Fatalf("case %v: id: expected: not found", i+1)
+		}
+	}
+}
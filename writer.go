@@ -21,9 +21,12 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"runtime/debug"
 
 	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/minio/parquet-go/bloom"
 	"github.com/minio/parquet-go/data"
+	"github.com/minio/parquet-go/encryption"
 	"github.com/minio/parquet-go/gen-go/parquet"
 	"github.com/minio/parquet-go/schema"
 )
@@ -31,13 +34,86 @@ import (
 const (
 	defaultPageSize     = 8 * 1024          // 8 KiB
 	defaultRowGroupSize = 128 * 1024 * 1024 // 128 MiB
+	modulePath          = "github.com/minio/parquet-go"
 )
 
+// defaultCreatedBy is created_by written by default; see Writer.CreatedBy.
+var defaultCreatedBy = "parquet-go version " + moduleVersion()
+
+// moduleVersion returns version of this module the binary is built with, or "(devel)" if unknown.
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+
+	if info.Main.Path == modulePath && info.Main.Version != "" {
+		return info.Main.Version
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil && dep.Replace.Version != "" {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+
+	return "(devel)"
+}
+
+func setKeyValue(keyValues []*parquet.KeyValue, key, value string) []*parquet.KeyValue {
+	for _, keyValue := range keyValues {
+		if keyValue.Key == key {
+			keyValue.Value = &value
+			return keyValues
+		}
+	}
+
+	return append(keyValues, &parquet.KeyValue{Key: key, Value: &value})
+}
+
+// columnBloomFilter - denotes Bloom filter to be written for column chunk metadata.
+type columnBloomFilter struct {
+	metadata        *parquet.ColumnMetaData
+	filter          *bloom.Filter
+	cipher          *encryption.Cipher
+	rowGroupOrdinal int16
+	columnOrdinal   int16
+}
+
+// marshal returns serialized Bloom filter. Header and bitset are encrypted separately if column is encrypted.
+func (bloomFilter columnBloomFilter) marshal() ([]byte, error) {
+	if bloomFilter.cipher == nil {
+		return bloomFilter.filter.MarshalBinary()
+	}
+
+	headerData, err := bloomFilter.filter.MarshalHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	headerModule, err := bloomFilter.cipher.Encrypt(encryption.BloomFilterHeader, bloomFilter.rowGroupOrdinal, bloomFilter.columnOrdinal, 0, headerData)
+	if err != nil {
+		return nil, err
+	}
+
+	bitsetModule, err := bloomFilter.cipher.Encrypt(encryption.BloomFilterBitset, bloomFilter.rowGroupOrdinal, bloomFilter.columnOrdinal, 0, bloomFilter.filter.Bitset())
+	if err != nil {
+		return nil, err
+	}
+
+	return append(headerModule, bitsetModule...), nil
+}
+
 // Writer - represents parquet writer.
 type Writer struct {
 	PageSize        int64
 	RowGroupSize    int64
 	CompressionType parquet.CompressionCodec
+	CreatedBy       string // Application written as created_by of the file; defaults to "parquet-go version <v>".
+	PageChecksum    bool   // Writes CRC32 checksum of compressed page data in page headers.
 
 	writeCloser   io.WriteCloser
 	numRows       int64
@@ -47,14 +123,77 @@ type Writer struct {
 	valueElements []*schema.Element
 	columnDataMap map[string]*data.Column
 	rowGroupCount int
+
+	columnKeyValues map[string][]*parquet.KeyValue
+	bloomFilters    []columnBloomFilter
+	encryptor       *fileEncryptor
+	closed          bool
 }
 
-func (writer *Writer) writeData() (err error) {
+// magic returns magic bytes written at the beginning and the end of the file.
+func (writer *Writer) magic() []byte {
+	if writer.encryptor != nil && !writer.encryptor.props.PlaintextFooter {
+		return []byte("PARE")
+	}
+
+	return []byte("PAR1")
+}
+
+// valueElement returns value element of column name which is path in schema tree.
+func (writer *Writer) valueElement(name string) *schema.Element {
+	for _, element := range writer.valueElements {
+		if element.PathInTree == name {
+			return element
+		}
+	}
+
+	return nil
+}
+
+func (writer *Writer) isValueColumn(name string) bool {
+	return writer.valueElement(name) != nil
+}
+
+// SetKeyValueMetadata - sets key/value metadata of the file. It may be called any time before Close.
+func (writer *Writer) SetKeyValueMetadata(key, value string) error {
+	if writer.closed {
+		return fmt.Errorf("writer already closed")
+	}
+
+	writer.footer.KeyValueMetadata = setKeyValue(writer.footer.KeyValueMetadata, key, value)
+	return nil
+}
+
+// SetColumnKeyValueMetadata - sets key/value metadata of every column chunk of column name. It may be called any time before Close.
+func (writer *Writer) SetColumnKeyValueMetadata(name, key, value string) error {
+	if writer.closed {
+		return fmt.Errorf("writer already closed")
+	}
+
+	element := writer.valueElement(name)
+	if element == nil {
+		return fmt.Errorf("%v is not value column", name)
+	}
+
+	// Key/values are kept by path in schema as column chunks are looked up by it.
+	if writer.columnKeyValues == nil {
+		writer.columnKeyValues = make(map[string][]*parquet.KeyValue)
+	}
+
+	writer.columnKeyValues[element.PathInSchema] = setKeyValue(writer.columnKeyValues[element.PathInSchema], key, value)
+	return nil
+}
+
+// writeData encodes and writes pending records as a row group. If ctx is done while encoding, nothing is
+// written and the records are kept pending.
+func (writer *Writer) writeData(ctx context.Context) (err error) {
 	if writer.numRows == 0 {
 		return nil
 	}
 
 	var chunks []*data.ColumnChunk
+	var bloomFilters []columnBloomFilter
+	rowGroupOrdinal := int16(len(writer.footer.RowGroups))
 	for _, element := range writer.valueElements {
 		name := element.PathInTree
 		columnData, found := writer.columnDataMap[name]
@@ -62,8 +201,37 @@ func (writer *Writer) writeData() (err error) {
 			continue
 		}
 
-		columnChunk := columnData.Encode(element)
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		cipher := writer.encryptor.columnCipher(element.PathInSchema)
+		columnOrdinal := int16(len(chunks))
+		columnChunk, err := columnData.EncodeWithOptions(element, data.EncodeOptions{
+			PageChecksum:    writer.PageChecksum,
+			Cipher:          cipher,
+			RowGroupOrdinal: rowGroupOrdinal,
+			ColumnOrdinal:   columnOrdinal,
+		})
+		if err != nil {
+			return err
+		}
 		chunks = append(chunks, columnChunk)
+
+		if filter := columnChunk.BloomFilter(); filter != nil {
+			bloomFilters = append(bloomFilters, columnBloomFilter{
+				metadata:        columnChunk.MetaData,
+				filter:          filter,
+				cipher:          cipher,
+				rowGroupOrdinal: rowGroupOrdinal,
+				columnOrdinal:   columnOrdinal,
+			})
+		}
+	}
+
+	// Row group is written entirely once encoded to keep offsets of written data consistent.
+	if err = ctx.Err(); err != nil {
+		return err
 	}
 
 	rowGroup := data.NewRowGroup(chunks, writer.numRows, writer.offset)
@@ -76,6 +244,7 @@ func (writer *Writer) writeData() (err error) {
 		writer.offset += chunk.DataLen()
 	}
 
+	writer.bloomFilters = append(writer.bloomFilters, bloomFilters...)
 	writer.footer.RowGroups = append(writer.footer.RowGroups, rowGroup)
 	writer.footer.NumRows += writer.numRows
 
@@ -86,30 +255,41 @@ func (writer *Writer) writeData() (err error) {
 
 // WriteJSON - writes a record represented in JSON.
 func (writer *Writer) WriteJSON(recordData []byte) (err error) {
+	return writer.WriteJSONContext(context.Background(), recordData)
+}
+
+// WriteJSONContext - writes a record represented in JSON like WriteContext.
+func (writer *Writer) WriteJSONContext(ctx context.Context, recordData []byte) (err error) {
 	columnDataMap, err := data.UnmarshalJSON(recordData, writer.schemaTree)
 	if err != nil {
 		return err
 	}
 
-	return writer.Write(columnDataMap)
+	return writer.WriteContext(ctx, columnDataMap)
 }
 
 // Write - writes a record represented in map.
 func (writer *Writer) Write(record map[string]*data.Column) (err error) {
+	return writer.WriteContext(context.Background(), record)
+}
+
+// WriteContext - writes a record like Write. If ctx is done, ctx.Err() is returned. If it is done while the
+// row group completed by the record is encoded, the record is kept and the row group is written by next
+// write or Close.
+func (writer *Writer) WriteContext(ctx context.Context, record map[string]*data.Column) (err error) {
+	if writer.closed {
+		return fmt.Errorf("writer already closed")
+	}
+
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
 	if writer.columnDataMap == nil {
 		writer.columnDataMap = record
 	} else {
 		for name, columnData := range record {
-			var found bool
-			var element *schema.Element
-			for _, element = range writer.valueElements {
-				if element.PathInTree == name {
-					found = true
-					break
-				}
-			}
-
-			if !found {
+			if !writer.isValueColumn(name) {
 				return fmt.Errorf("%v is not value column", name)
 			}
 
@@ -118,25 +298,68 @@ func (writer *Writer) Write(record map[string]*data.Column) (err error) {
 	}
 
 	writer.numRows++
-	if writer.numRows == int64(writer.rowGroupCount) {
-		return writer.writeData()
+	if writer.numRows >= int64(writer.rowGroupCount) {
+		return writer.writeData(ctx)
 	}
 
 	return nil
 }
 
-func (writer *Writer) finalize() (err error) {
-	if err = writer.writeData(); err != nil {
+func (writer *Writer) finalize(ctx context.Context) (err error) {
+	if err = writer.writeData(ctx); err != nil {
 		return err
 	}
 
-	ts := thrift.NewTSerializer()
-	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
-	footerBuf, err := ts.Write(context.TODO(), writer.footer)
+	// Bloom filters are written after all row groups.
+	for _, bloomFilter := range writer.bloomFilters {
+		filterData, err := bloomFilter.marshal()
+		if err != nil {
+			return err
+		}
+
+		if _, err = writer.writeCloser.Write(filterData); err != nil {
+			return err
+		}
+
+		bloomFilterOffset := writer.offset
+		bloomFilter.metadata.BloomFilterOffset = &bloomFilterOffset
+		writer.offset += int64(len(filterData))
+	}
+
+	// created_by is written also if empty; files without it are read as of legacy layout.
+	writer.footer.CreatedBy = &writer.CreatedBy
+
+	for rowGroupOrdinal, rowGroup := range writer.footer.RowGroups {
+		for columnOrdinal, columnChunk := range rowGroup.Columns {
+			name := schema.JoinPath(columnChunk.MetaData.PathInSchema)
+			if keyValues, found := writer.columnKeyValues[name]; found {
+				columnChunk.MetaData.KeyValueMetadata = keyValues
+			}
+
+			if writer.encryptor != nil {
+				if err = writer.encryptor.encryptColumnChunk(columnChunk, int16(rowGroupOrdinal), int16(columnOrdinal)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	var footerBuf []byte
+	if writer.encryptor != nil {
+		footerBuf, err = writer.encryptor.encryptFooter(writer.footer)
+	} else {
+		ts := thrift.NewTSerializer()
+		ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+		footerBuf, err = ts.Write(ctx, writer.footer)
+	}
 	if err != nil {
 		return err
 	}
 
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
 	if _, err = writer.writeCloser.Write(footerBuf); err != nil {
 		return err
 	}
@@ -148,38 +371,73 @@ func (writer *Writer) finalize() (err error) {
 		return err
 	}
 
-	_, err = writer.writeCloser.Write([]byte("PAR1"))
+	_, err = writer.writeCloser.Write(writer.magic())
 	return err
 }
 
 // Close - finalizes and closes writer. If any pending records are available, they are written here.
 func (writer *Writer) Close() (err error) {
-	if err = writer.finalize(); err != nil {
-		return err
+	return writer.CloseContext(context.Background())
+}
+
+// CloseContext - finalizes and closes writer like Close. Once ctx is done, encoding and writing of pending
+// records and footer is stopped and ctx.Err() is returned; the written data is not a valid parquet file.
+// Underneath writer is closed even if error is returned.
+func (writer *Writer) CloseContext(ctx context.Context) (err error) {
+	if writer.closed {
+		return fmt.Errorf("writer already closed")
+	}
+	writer.closed = true
+
+	err = writer.finalize(ctx)
+	if closeErr := writer.writeCloser.Close(); err == nil {
+		err = closeErr
 	}
 
-	return writer.writeCloser.Close()
+	return err
 }
 
 // NewWriter - creates new parquet writer. Binary data of rowGroupCount records are written to writeCloser.
 func NewWriter(writeCloser io.WriteCloser, schemaTree *schema.Tree, rowGroupCount int) (*Writer, error) {
-	if _, err := writeCloser.Write([]byte("PAR1")); err != nil {
-		return nil, err
-	}
+	return NewEncryptedWriter(writeCloser, schemaTree, rowGroupCount, nil)
+}
 
-	schemaList, valueElements, err := schemaTree.ToParquetSchema()
+// NewEncryptedWriter - creates new parquet writer encrypting the file as per props. If props is nil, the file is not encrypted.
+func NewEncryptedWriter(writeCloser io.WriteCloser, schemaTree *schema.Tree, rowGroupCount int, props *FileEncryptionProperties) (*Writer, error) {
+	schemaList, valueElements, err := schemaTree.ParquetSchema()
 	if err != nil {
 		return nil, err
 	}
 
+	var encryptor *fileEncryptor
+	if props != nil {
+		for name := range props.ColumnKeys {
+			found := false
+			for _, element := range valueElements {
+				if found = element.PathInSchema == name; found {
+					break
+				}
+			}
+
+			if !found {
+				return nil, fmt.Errorf("%v is not value column", name)
+			}
+		}
+
+		if encryptor, err = newFileEncryptor(props); err != nil {
+			return nil, err
+		}
+	}
+
 	footer := parquet.NewFileMetaData()
 	footer.Version = 1
 	footer.Schema = schemaList
 
-	return &Writer{
+	writer := &Writer{
 		PageSize:        defaultPageSize,
 		RowGroupSize:    defaultRowGroupSize,
 		CompressionType: parquet.CompressionCodec_SNAPPY,
+		CreatedBy:       defaultCreatedBy,
 
 		writeCloser:   writeCloser,
 		offset:        4,
@@ -187,5 +445,12 @@ func NewWriter(writeCloser io.WriteCloser, schemaTree *schema.Tree, rowGroupCoun
 		schemaTree:    schemaTree,
 		valueElements: valueElements,
 		rowGroupCount: rowGroupCount,
-	}, nil
+		encryptor:     encryptor,
+	}
+
+	if _, err = writeCloser.Write(writer.magic()); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
 }
@@ -17,9 +17,17 @@
 package parquet
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/minio/parquet-go/data"
 	"github.com/minio/parquet-go/gen-go/parquet"
 	"github.com/minio/parquet-go/schema"
@@ -150,3 +158,388 @@ func TestWriterWriteJSON(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+type bufferWriteCloser struct {
+	bytes.Buffer
+}
+
+func (b *bufferWriteCloser) Close() error {
+	return nil
+}
+
+func TestWriterSchemaTree(t *testing.T) {
+	parsedTree, err := schema.Parse("message m { required int64 id; optional binary name (UTF8); }")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inferredTree, err := schema.InferFromJSON([]byte(`{"id": 1, "name": "foo"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []*schema.Tree{parsedTree, inferredTree}
+	for i, testCase := range testCases {
+		buf := new(bufferWriteCloser)
+		writer, err := NewWriter(buf, testCase, 10)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if err = writer.WriteJSON([]byte(`{"id": 1, "name": "foo"}`)); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if err = writer.Close(); err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		record, err := reader.Read()
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if name, _ := record.Get("name"); !reflect.DeepEqual(name.Value, []byte("foo")) {
+			t.Fatalf("case %v: name: expected: foo, got: %v", i+1, name)
+		}
+	}
+}
+
+func TestWriterKeyValueMetadata(t *testing.T) {
+	schemaTree := schema.NewTree()
+	{
+		one, err := schema.NewElement("one", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Key of the tree differs from name of the element.
+		two, err := schema.NewElement("two", parquet.FieldRepetitionType_REQUIRED,
+			parquet.TypePtr(parquet.Type_INT32), nil, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := schemaTree.Set("one", one); err != nil {
+			t.Fatal(err)
+		}
+		if err := schemaTree.Set("renamed", two); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, schemaTree, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.CreatedBy = "test version 1.0.0"
+
+	if err = writer.SetKeyValueMetadata("source", "kafka"); err != nil {
+		t.Fatal(err)
+	}
+
+	oneColumn := data.NewColumn(parquet.Type_INT32)
+	oneColumn.AddInt32(100, 0, 0)
+	twoColumn := data.NewColumn(parquet.Type_INT32)
+	twoColumn.AddInt32(200, 0, 0)
+	if err = writer.Write(map[string]*data.Column{"one": oneColumn, "renamed": twoColumn}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.SetKeyValueMetadata("offset", "42"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.SetColumnKeyValueMetadata("one", "lineage", "input.one"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.SetColumnKeyValueMetadata("renamed", "lineage", "input.two"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.SetColumnKeyValueMetadata("missing", "lineage", "input.missing"); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = writer.SetKeyValueMetadata("offset", "43"); err == nil {
+		t.Fatalf("expected: <error>, got: <nil>")
+	}
+
+	reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	expectedKeyValues := map[string]string{"source": "kafka", "offset": "42"}
+	if keyValues := reader.KeyValueMetadata(); !reflect.DeepEqual(keyValues, expectedKeyValues) {
+		t.Fatalf("key/value metadata: expected: %v, got: %v", expectedKeyValues, keyValues)
+	}
+
+	columnKeyValues, err := reader.ColumnKeyValueMetadata(0, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedColumnKeyValues := map[string]string{"lineage": "input.one"}
+	if !reflect.DeepEqual(columnKeyValues, expectedColumnKeyValues) {
+		t.Fatalf("column key/value metadata: expected: %v, got: %v", expectedColumnKeyValues, columnKeyValues)
+	}
+
+	columnKeyValues, err = reader.ColumnKeyValueMetadata(0, "two")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedColumnKeyValues = map[string]string{"lineage": "input.two"}
+	if !reflect.DeepEqual(columnKeyValues, expectedColumnKeyValues) {
+		t.Fatalf("column key/value metadata: expected: %v, got: %v", expectedColumnKeyValues, columnKeyValues)
+	}
+
+	if reader.CreatedBy() != writer.CreatedBy {
+		t.Fatalf("created by: expected: %v, got: %v", writer.CreatedBy, reader.CreatedBy())
+	}
+}
+
+func TestWriterByteStreamSplit(t *testing.T) {
+	codecs := []parquet.CompressionCodec{
+		parquet.CompressionCodec_UNCOMPRESSED,
+		parquet.CompressionCodec_SNAPPY,
+		parquet.CompressionCodec_GZIP,
+		parquet.CompressionCodec_LZ4,
+		parquet.CompressionCodec_ZSTD,
+		parquet.CompressionCodec_BROTLI,
+		parquet.CompressionCodec_LZ4_RAW,
+	}
+
+	for _, codec := range codecs {
+		schemaTree := schema.NewTree()
+		{
+			one, err := schema.NewElement("one", parquet.FieldRepetitionType_OPTIONAL,
+				parquet.TypePtr(parquet.Type_DOUBLE), nil,
+				parquet.EncodingPtr(parquet.Encoding_BYTE_STREAM_SPLIT), parquet.CompressionCodecPtr(codec), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			two, err := schema.NewElement("two", parquet.FieldRepetitionType_REQUIRED,
+				parquet.TypePtr(parquet.Type_FLOAT), nil,
+				parquet.EncodingPtr(parquet.Encoding_BYTE_STREAM_SPLIT), parquet.CompressionCodecPtr(codec), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := schemaTree.Set("one", one); err != nil {
+				t.Fatal(err)
+			}
+			if err := schemaTree.Set("two", two); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		buf := new(bufferWriteCloser)
+		writer, err := NewWriter(buf, schemaTree, 10)
+		if err != nil {
+			t.Fatalf("%v: %v", codec, err)
+		}
+
+		for i := 0; i < 20; i++ {
+			if err = writer.WriteJSON([]byte(fmt.Sprintf(`{"one": %v.25, "two": -%v.5}`, i, i))); err != nil {
+				t.Fatalf("%v: %v", codec, err)
+			}
+		}
+
+		if err = writer.Close(); err != nil {
+			t.Fatalf("%v: %v", codec, err)
+		}
+
+		reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+		if err != nil {
+			t.Fatalf("%v: %v", codec, err)
+		}
+
+		if reader.CreatedBy() != defaultCreatedBy || !strings.HasPrefix(defaultCreatedBy, "parquet-go version ") {
+			t.Fatalf("%v: created by: expected: %v, got: %v", codec, defaultCreatedBy, reader.CreatedBy())
+		}
+
+		for i := 0; i < 20; i++ {
+			record, err := reader.Read()
+			if err != nil {
+				t.Fatalf("%v: %v", codec, err)
+			}
+
+			one, _ := record.Get("one")
+			if expected := float64(i) + 0.25; one.Value != expected {
+				t.Fatalf("%v: record %v: expected: %v, got: %v", codec, i, expected, one.Value)
+			}
+
+			two, _ := record.Get("two")
+			if expected := -(float32(i) + 0.5); two.Value != expected {
+				t.Fatalf("%v: record %v: expected: %v, got: %v", codec, i, expected, two.Value)
+			}
+		}
+
+		if _, err = reader.Read(); err != io.EOF {
+			t.Fatalf("%v: expected: %v, got: %v", codec, io.EOF, err)
+		}
+		reader.Close()
+	}
+}
+
+// dictionaryDataPage returns uncompressed data page of dictionary encoded column chunk.
+func dictionaryDataPage(t *testing.T, chunkData []byte, codec parquet.CompressionCodec) []byte {
+	buffer := thrift.NewTMemoryBuffer()
+	buffer.Write(chunkData)
+	for buffer.Len() > 0 {
+		pageHeader := parquet.NewPageHeader()
+		if err := pageHeader.Read(context.Background(), thrift.NewTCompactProtocol(buffer)); err != nil {
+			t.Fatal(err)
+		}
+
+		pageData := buffer.Next(int(pageHeader.CompressedPageSize))
+		if pageHeader.Type != parquet.PageType_DATA_PAGE {
+			continue
+		}
+
+		data, err := compressionCodec(codec).uncompress(pageData)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return data
+	}
+
+	t.Fatal("data page not found")
+	return nil
+}
+
+func TestWriterDictionaryPageLayout(t *testing.T) {
+	// Column 'one' of example.parquet written by parquet-cpp has values -1, null and 2.5.
+	file, err := ioutil.ReadFile("example.parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileMeta, _, err := fileMetadata(bytesGetReaderFunc(file), DefaultFooterReadSize, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metadata := fileMeta.RowGroups[0].Columns[0].MetaData
+	start := metadata.GetDataPageOffset()
+	if metadata.DictionaryPageOffset != nil {
+		start = *metadata.DictionaryPageOffset
+	}
+	referencePage := dictionaryDataPage(t, file[start:start+metadata.TotalCompressedSize], metadata.Codec)
+
+	tree, err := schema.Parse("message m { optional double one; required double two; }")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	one, _ := tree.Get("one")
+	oneColumn := data.NewColumn(parquet.Type_DOUBLE)
+	oneColumn.AddDouble(-1, 1, 0)
+	oneColumn.AddNull(0, 0)
+	oneColumn.AddDouble(2.5, 1, 0)
+	onePage := dictionaryDataPage(t, oneColumn.Encode(one).Data(), parquet.CompressionCodec_SNAPPY)
+
+	two, _ := tree.Get("two")
+	twoColumn := data.NewColumn(parquet.Type_DOUBLE)
+	twoColumn.AddDouble(-1, 0, 0)
+	twoColumn.AddDouble(2.5, 0, 0)
+	twoColumn.AddDouble(2.5, 0, 0)
+	twoPage := dictionaryDataPage(t, twoColumn.Encode(two).Data(), parquet.CompressionCodec_SNAPPY)
+
+	// Data page v1 of dictionary encoded column has length prefixed definition levels, if max definition level
+	// is non-zero, followed by bit width of indices and indices without length prefix.
+	testCases := []struct {
+		page                     []byte
+		maxDefinitionLevel       uint64
+		expectedDefinitionLevels []int64
+		expectedIndices          []int64
+	}{
+		{referencePage, 1, []int64{1, 0, 1}, []int64{0, 1}},
+		{onePage, 1, []int64{1, 0, 1}, []int64{0, 1}},
+		{twoPage, 0, nil, []int64{0, 1, 1}},
+	}
+
+	for i, testCase := range testCases {
+		reader := bytes.NewReader(testCase.page)
+
+		var definitionLevels []int64
+		if testCase.maxDefinitionLevel > 0 {
+			if definitionLevels, err = readRLEBitPackedHybrid(reader, 0, testCase.maxDefinitionLevel, 3); err != nil {
+				t.Fatalf("case %v: %v", i+1, err)
+			}
+		}
+
+		if !reflect.DeepEqual(definitionLevels, testCase.expectedDefinitionLevels) {
+			t.Fatalf("case %v: definition levels: expected: %v, got: %v", i+1, testCase.expectedDefinitionLevels, definitionLevels)
+		}
+
+		bitWidth, err := reader.ReadByte()
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if bitWidth != 1 {
+			t.Fatalf("case %v: bit width: expected: 1, got: %v", i+1, bitWidth)
+		}
+
+		indices, err := readRLEBitPackedHybrid(reader, uint64(reader.Len()), uint64(bitWidth), uint64(len(testCase.expectedIndices)))
+		if err != nil {
+			t.Fatalf("case %v: %v", i+1, err)
+		}
+
+		if !reflect.DeepEqual(indices, testCase.expectedIndices) {
+			t.Fatalf("case %v: indices: expected: %v, got: %v", i+1, testCase.expectedIndices, indices)
+		}
+	}
+}
+
+func TestWriterEmptyCreatedBy(t *testing.T) {
+	// Empty created_by is written, hence pages are not read as of legacy layout.
+	buf := new(bufferWriteCloser)
+	writer, err := NewWriter(buf, int32SchemaTree(t), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.CreatedBy = ""
+
+	for i := 1; i <= 3; i++ {
+		if err = writer.WriteJSON([]byte(fmt.Sprintf(`{"a": %v}`, i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewReader(bytesGetReaderFunc(buf.Bytes()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if !reader.FileMetaData().IsSetCreatedBy() || reader.CreatedBy() != "" {
+		t.Fatalf("created by: expected: set and empty, got: %q", reader.CreatedBy())
+	}
+
+	if result := readColumnA(t, reader); result != "[1 2 3]" {
+		t.Fatalf("expected: [1 2 3], got: %v", result)
+	}
+}